@@ -0,0 +1,114 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/token"
+	"os"
+	"path/filepath"
+
+	"github.com/victornechaev/loglint/internal/baseline"
+	"github.com/victornechaev/loglint/pkg/loglint"
+	"golang.org/x/tools/go/packages"
+)
+
+// writeBaseline реализует команду `loglint baseline write [-o path] [flags] [packages]`:
+// запускает анализатор без baseline и записывает все текущие находки. Флаги анализатора
+// (-config, -disable и другие) действуют так же, как при обычном запуске.
+func writeBaseline(args []string) int {
+	cli := newCLIAnalyzer()
+	flags := flag.NewFlagSet("loglint baseline write", flag.ContinueOnError)
+	output := flags.String("o", "", "baseline file to write (default: baseline from the config or "+baseline.DefaultPath+")")
+	cli.registerFlags(flags)
+	if err := flags.Parse(args); err != nil {
+		return 2
+	}
+
+	cfg, err := cli.config()
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 1
+	}
+
+	if *output == "" {
+		*output = cfg.Baseline
+	}
+	if *output == "" {
+		*output = baseline.DefaultPath
+	}
+
+	analyzer, err := loglint.NewAnalyzer(cli.options(cfg))
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 1
+	}
+
+	findings, err := analyze(analyzer, flags.Args(), true)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 1
+	}
+
+	base, err := baseline.New(*output)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 1
+	}
+
+	for _, f := range findings {
+		fn := ""
+		if file := fileOf(f.pkg, f.diag.Pos); file != nil {
+			fn = baseline.EnclosingFunc(file, f.diag.Pos)
+		}
+
+		rule, _ := loglint.SplitCategory(f.diag.Category)
+		base.Entries = append(base.Entries, base.NewEntry(rule, f.position.Filename, fn, f.diag.Message))
+	}
+
+	dropped := deletedFileEntries(*output)
+	if err := base.Write(*output); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 1
+	}
+
+	fmt.Printf("%s: %d entries\n", *output, len(base.Entries))
+	for _, entry := range dropped {
+		fmt.Printf("%s: dropped entry for deleted file %s: %s finding %q\n", *output, entry.File, entry.Rule, entry.Message)
+	}
+	return 0
+}
+
+// deletedFileEntries возвращает записи прежнего baseline из path для файлов, которых больше
+// нет. Обычный запуск сообщает о них, только пока существует каталог пакета, поэтому
+// перезапись baseline называет их явно.
+func deletedFileEntries(path string) []baseline.Entry {
+	old, err := baseline.Load(path)
+	if err != nil {
+		return nil
+	}
+
+	var deleted []baseline.Entry
+	for _, entry := range old.Entries {
+		file := filepath.FromSlash(entry.File)
+		if !filepath.IsAbs(file) {
+			file = filepath.Join(old.Root, file)
+		}
+		if _, err := os.Stat(file); errors.Is(err, os.ErrNotExist) {
+			deleted = append(deleted, entry)
+		}
+	}
+
+	return deleted
+}
+
+func fileOf(pkg *packages.Package, pos token.Pos) *ast.File {
+	for _, file := range pkg.Syntax {
+		if file.FileStart <= pos && pos <= file.FileEnd {
+			return file
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,40 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/victornechaev/loglint/internal/baseline"
+)
+
+func TestDeletedFileEntries(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "kept.go"), []byte("package app\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	path := filepath.Join(dir, baseline.DefaultPath)
+	base, err := baseline.New(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	base.Entries = []baseline.Entry{
+		{Rule: "lowercase", File: "kept.go", Message: "m"},
+		{Rule: "sensitive", File: "removed/old.go", Message: "m"},
+	}
+	if err := base.Write(path); err != nil {
+		t.Fatal(err)
+	}
+
+	deleted := deletedFileEntries(path)
+	if len(deleted) != 1 || deleted[0].File != "removed/old.go" {
+		t.Fatalf("deletedFileEntries() = %+v, want the removed/old.go entry", deleted)
+	}
+
+	if deleted := deletedFileEntries(filepath.Join(dir, "absent.json")); deleted != nil {
+		t.Fatalf("deletedFileEntries() without a baseline = %+v", deleted)
+	}
+}
@@ -0,0 +1,158 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"go/token"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/victornechaev/loglint/pkg/loglint"
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/analysis/checker"
+	"golang.org/x/tools/go/packages"
+)
+
+// finding — диагностика вместе с пакетом, в котором она найдена.
+type finding struct {
+	diag     analysis.Diagnostic
+	pkg      *packages.Package
+	position token.Position
+}
+
+// lint — основной режим CLI. В отличие от singlechecker печатает уровень важности
+// каждой диагностики и завершается с кодом 3, только если есть находки уровня error.
+func lint(cli *cliAnalyzer, args []string) int {
+	flags := flag.NewFlagSet("loglint", flag.ContinueOnError)
+	tests := flags.Bool("test", true, "indicates whether test files should be analyzed, too")
+	format := flags.String("format", "text", "output format: text or sarif (SARIF 2.1.0 to stdout)")
+	cli.registerFlags(flags)
+	if err := flags.Parse(args); err != nil {
+		return 2
+	}
+	if *format != "text" && *format != "sarif" {
+		fmt.Fprintf(os.Stderr, "unknown format %q\n", *format)
+		return 2
+	}
+
+	analyzer, err := cli.build()
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 1
+	}
+
+	findings, err := analyze(analyzer, flags.Args(), *tests)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 1
+	}
+
+	if *format == "sarif" {
+		if err := writeSARIF(os.Stdout, findings, ".", ruleSeverities(cli.built)); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			return 1
+		}
+	}
+
+	exitCode := 0
+	for _, f := range findings {
+		rule, severity := loglint.SplitCategory(f.diag.Category)
+		if *format == "text" {
+			fmt.Fprintf(os.Stderr, "%s: %s: %s (%s)\n", f.position, severity, f.diag.Message, rule)
+		}
+		if severity == loglint.SeverityError {
+			exitCode = 3
+		}
+	}
+
+	return exitCode
+}
+
+// needsSinglechecker сообщает, нужен ли запуск через singlechecker: собственный драйвер
+// поддерживает только флаги -test, -format и флаги анализатора из analyzerFlags, а -fix,
+// -diff, -json, -c и запуск из go vet остаются за ним. singlechecker не печатает уровни
+// важности и завершается по своим правилам: -fix и -json — с кодом 0, текстовый вывод —
+// с кодом 3 при любой находке.
+func needsSinglechecker(args []string, analyzerFlags *flag.FlagSet) bool {
+	if len(args) == 1 && strings.HasSuffix(args[0], ".cfg") {
+		return true
+	}
+
+	for _, arg := range args {
+		if arg == "--" || !strings.HasPrefix(arg, "-") {
+			continue
+		}
+
+		name, _, _ := strings.Cut(strings.TrimLeft(arg, "-"), "=")
+		if name != "test" && name != "format" && analyzerFlags.Lookup(name) == nil {
+			return true
+		}
+	}
+
+	return false
+}
+
+// analyze загружает пакеты по шаблонам и возвращает диагностики в порядке позиций.
+// Файлы пакета входят и в его тестовый вариант, поэтому повторы отбрасываются.
+func analyze(analyzer *analysis.Analyzer, patterns []string, tests bool) ([]finding, error) {
+	graph, err := loadAndAnalyze(analyzer, patterns, tests)
+	if err != nil {
+		return nil, err
+	}
+
+	type seenKey struct {
+		pos     token.Position
+		message string
+	}
+	var (
+		findings []finding
+		seen     = make(map[seenKey]bool)
+	)
+	for _, act := range graph.Roots {
+		if act.Err != nil {
+			return nil, act.Err
+		}
+
+		for _, diag := range act.Diagnostics {
+			position := act.Package.Fset.Position(diag.Pos)
+			key := seenKey{pos: position, message: diag.Message}
+			if seen[key] {
+				continue
+			}
+			seen[key] = true
+			findings = append(findings, finding{diag: diag, pkg: act.Package, position: position})
+		}
+	}
+
+	sort.Slice(findings, func(i, j int) bool {
+		x, y := findings[i].position, findings[j].position
+		if x.Filename != y.Filename {
+			return x.Filename < y.Filename
+		}
+		if x.Line != y.Line {
+			return x.Line < y.Line
+		}
+		return x.Column < y.Column
+	})
+
+	return findings, nil
+}
+
+// loadAndAnalyze загружает пакеты по шаблонам (по умолчанию ./...) и запускает на них analyzer.
+func loadAndAnalyze(analyzer *analysis.Analyzer, patterns []string, tests bool) (*checker.Graph, error) {
+	if len(patterns) == 0 {
+		patterns = []string{"./..."}
+	}
+
+	pkgs, err := packages.Load(&packages.Config{Mode: packages.LoadAllSyntax, Tests: tests}, patterns...)
+	if err != nil {
+		return nil, err
+	}
+	if packages.PrintErrors(pkgs) > 0 {
+		return nil, errors.New("failed to load packages")
+	}
+
+	return checker.Analyze([]*analysis.Analyzer{analyzer}, pkgs, nil)
+}
@@ -0,0 +1,59 @@
+package main
+
+import "testing"
+
+func TestLintExitCodes(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		config string
+		args   []string
+		want   int
+	}{
+		{name: "error finding", config: `{}`, want: 3},
+		{name: "warning finding", config: `{"severity": {"lowercase": "warning"}}`, want: 0},
+		{name: "severity flag", config: `{}`, args: []string{"-severity", "lowercase=info"}, want: 0},
+		{name: "disabled rule", config: `{}`, args: []string{"-disable", "lowercase"}, want: 0},
+		{name: "sarif", config: `{}`, args: []string{"-format", "sarif"}, want: 3},
+		{name: "unknown format", config: `{}`, args: []string{"-format", "xml"}, want: 2},
+		{name: "invalid config", config: `{"severity": {"lowercase": "fatal"}}`, want: 1},
+		{name: "unknown rule flag", config: `{}`, args: []string{"-severity", "upper=error"}, want: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			args := append([]string{"-config", writeConfig(t, tt.config)}, tt.args...)
+			args = append(args, "./testdata/lint")
+			if got := lint(newCLIAnalyzer(), args); got != tt.want {
+				t.Fatalf("lint(%v) = %d, want %d", tt.args, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNeedsSinglechecker(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		args []string
+		want bool
+	}{
+		{args: []string{"./..."}},
+		{args: []string{"-test=false", "-format", "sarif", "-config", "x.json", "./..."}},
+		{args: []string{"--severity=lowercase=warning", "./..."}},
+		{args: []string{"-fix", "./..."}, want: true},
+		{args: []string{"-diff", "./..."}, want: true},
+		{args: []string{"-json", "./..."}, want: true},
+		{args: []string{"-c", "1", "./..."}, want: true},
+		{args: []string{"vet.cfg"}, want: true},
+	}
+
+	for _, tt := range tests {
+		if got := needsSinglechecker(tt.args, &newCLIAnalyzer().Flags); got != tt.want {
+			t.Errorf("needsSinglechecker(%v) = %v, want %v", tt.args, got, tt.want)
+		}
+	}
+}
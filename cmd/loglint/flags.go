@@ -0,0 +1,225 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/victornechaev/loglint/internal/config"
+	"github.com/victornechaev/loglint/pkg/loglint"
+	"golang.org/x/tools/go/analysis"
+)
+
+// cliAnalyzer — анализатор отдельного бинарника. Настройки берутся из конфига и флагов
+// анализатора (analysis.Analyzer.Flags), поэтому настоящий анализатор собирается при
+// первом запуске, когда флаги уже разобраны: singlechecker, go vet -vettool или lint.
+type cliAnalyzer struct {
+	*analysis.Analyzer
+
+	configPath        string
+	disable           listFlag
+	enable            listFlag
+	sensitivePatterns listFlag
+	severity          severityFlag
+	noFix             bool
+
+	once  sync.Once
+	inner *analysis.Analyzer
+	// built — параметры, из которых собран inner.
+	built loglint.Options
+	err   error
+}
+
+func newCLIAnalyzer() *cliAnalyzer {
+	c := &cliAnalyzer{severity: severityFlag{}}
+	c.Analyzer = &analysis.Analyzer{
+		Name:      loglint.Analyzer.Name,
+		Doc:       loglint.Analyzer.Doc,
+		Run:       c.run,
+		FactTypes: loglint.Analyzer.FactTypes,
+	}
+
+	c.Flags.StringVar(&c.configPath, "config", "", "config file (default: discovered up to the module root)")
+	c.Flags.Var(&c.disable, "disable", "comma-separated rules to disable in addition to the config")
+	c.Flags.Var(&c.enable, "enable", "comma-separated rules to enable even if the config disables them")
+	c.Flags.Var(&c.sensitivePatterns, "sensitive-pattern", "additional sensitive data pattern (repeatable)")
+	c.Flags.Var(c.severity, "severity", "rule severity as rule=level, comma-separated (repeatable)")
+	c.Flags.BoolVar(&c.noFix, "no-fix", false, "do not suggest fixes")
+
+	return c
+}
+
+// config загружает конфиг из -config или найденный через config.Discover.
+func (c *cliAnalyzer) config() (config.Config, error) {
+	return config.LoadTree(c.configPath)
+}
+
+// options переводит конфиг в параметры анализатора и применяет поверх флаги.
+// Флаги действуют на весь код, overrides из конфига применяются уже после них.
+func (c *cliAnalyzer) options(cfg config.Config) loglint.Options {
+	options := analyzerOptions(cfg)
+
+	disabled := append(slices.Clone(options.DisabledRules), c.disable...)
+	options.DisabledRules = slices.DeleteFunc(disabled, func(rule string) bool {
+		return slices.Contains(c.enable, strings.ToLower(rule))
+	})
+
+	severity := make(map[string]string, len(options.Severity)+len(c.severity))
+	for rule, level := range options.Severity {
+		if level == loglint.SeverityOff && slices.Contains(c.enable, strings.ToLower(rule)) {
+			continue
+		}
+		severity[rule] = level
+	}
+	for rule, level := range c.severity {
+		severity[rule] = level
+	}
+	options.Severity = severity
+
+	// Правило taint включается не уровнем важности, а Options.Taint.
+	if slices.Contains(c.enable, "taint") {
+		options.Taint = true
+	}
+
+	options.SensitivePatterns = append(slices.Clone(options.SensitivePatterns), c.sensitivePatterns...)
+	options.DisableFixes = options.DisableFixes || c.noFix
+
+	return options
+}
+
+// build один раз собирает настоящий анализатор вместе с baseline из конфига.
+func (c *cliAnalyzer) build() (*analysis.Analyzer, error) {
+	c.once.Do(func() {
+		cfg, err := c.config()
+		if err != nil {
+			c.err = err
+			return
+		}
+
+		options := c.options(cfg)
+		if cfg.Baseline != "" {
+			if options.Baseline, err = loglint.LoadBaseline(cfg.Baseline); err != nil {
+				c.err = err
+				return
+			}
+		}
+
+		c.built = options
+		c.inner, c.err = loglint.NewAnalyzer(options)
+	})
+
+	return c.inner, c.err
+}
+
+// prepare собирает анализатор до запуска singlechecker. Граф анализаторов строится
+// раньше первого run, а SSA нужно запрашивать, только если включено правило taint,
+// поэтому флаги анализатора (в том числе -config) разбираются из аргументов заранее.
+// Ошибку флага сообщит singlechecker, ошибку сборки — run.
+func (c *cliAnalyzer) prepare(args []string) {
+	if err := c.presetFlags(args); err != nil {
+		return
+	}
+
+	if inner, err := c.build(); err == nil {
+		c.Requires = inner.Requires
+	}
+}
+
+// presetFlags применяет флаги анализатора из аргументов командной строки; остальные
+// флаги и аргументы пропускаются.
+func (c *cliAnalyzer) presetFlags(args []string) error {
+	for i := 0; i < len(args); i++ {
+		arg := args[i]
+		if arg == "--" {
+			break
+		}
+		if !strings.HasPrefix(arg, "-") {
+			continue
+		}
+
+		name, value, hasValue := strings.Cut(strings.TrimLeft(arg, "-"), "=")
+		f := c.Flags.Lookup(name)
+		if f == nil {
+			continue
+		}
+
+		if !hasValue {
+			if boolFlag, ok := f.Value.(interface{ IsBoolFlag() bool }); ok && boolFlag.IsBoolFlag() {
+				value = "true"
+			} else if i+1 < len(args) {
+				i++
+				value = args[i]
+			}
+		}
+		if err := f.Value.Set(value); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (c *cliAnalyzer) run(pass *analysis.Pass) (any, error) {
+	inner, err := c.build()
+	if err != nil {
+		return nil, err
+	}
+
+	return inner.Run(pass)
+}
+
+// registerFlags добавляет флаги анализатора в набор флагов подкоманды.
+func (c *cliAnalyzer) registerFlags(flags *flag.FlagSet) {
+	c.Flags.VisitAll(func(f *flag.Flag) {
+		flags.Var(f.Value, f.Name, f.Usage)
+	})
+}
+
+// listFlag — повторяемый флаг со списком значений через запятую.
+type listFlag []string
+
+func (l *listFlag) String() string {
+	return strings.Join(*l, ",")
+}
+
+func (l *listFlag) Set(value string) error {
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			*l = append(*l, strings.ToLower(item))
+		}
+	}
+
+	return nil
+}
+
+// severityFlag — повторяемый флаг уровней важности: "sensitive=error,lowercase=warning".
+// Имена правил и уровни проверяет loglint.NewAnalyzer.
+type severityFlag map[string]string
+
+func (s severityFlag) String() string {
+	pairs := make([]string, 0, len(s))
+	for rule, level := range s {
+		pairs = append(pairs, rule+"="+level)
+	}
+	slices.Sort(pairs)
+
+	return strings.Join(pairs, ",")
+}
+
+func (s severityFlag) Set(value string) error {
+	for _, pair := range strings.Split(value, ",") {
+		if pair = strings.TrimSpace(pair); pair == "" {
+			continue
+		}
+
+		rule, level, ok := strings.Cut(pair, "=")
+		if !ok {
+			return fmt.Errorf("want rule=level, got %q", pair)
+		}
+		s[strings.TrimSpace(rule)] = strings.TrimSpace(level)
+	}
+
+	return nil
+}
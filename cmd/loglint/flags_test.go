@@ -0,0 +1,135 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/victornechaev/loglint/internal/config"
+	"golang.org/x/tools/go/analysis/passes/buildssa"
+)
+
+func writeConfig(t *testing.T, content string) string {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), ".loglint.json")
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatalf("write config: %v", err)
+	}
+
+	return path
+}
+
+func TestPrepareRequiresSSAOnlyForTaint(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		config  string
+		args    []string
+		wantSSA bool
+	}{
+		{name: "taint off", config: `{}`},
+		{name: "taint on", config: `{"taint": {"enabled": true}}`, wantSSA: true},
+		{name: "enable flag", config: `{}`, args: []string{"-enable=taint"}, wantSSA: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			c := newCLIAnalyzer()
+			args := append([]string{"-fix", "-config", writeConfig(t, tt.config)}, tt.args...)
+			c.prepare(append(args, "./..."))
+
+			gotSSA := len(c.Requires) == 1 && c.Requires[0] == buildssa.Analyzer
+			if gotSSA != tt.wantSSA || len(c.Requires) > 1 {
+				t.Fatalf("Requires = %v, want SSA %v", c.Requires, tt.wantSSA)
+			}
+		})
+	}
+}
+
+func TestPresetFlags(t *testing.T) {
+	t.Parallel()
+
+	c := newCLIAnalyzer()
+	args := []string{"-json", "-config=loglint.json", "-no-fix", "-c", "1", "-disable", "lowercase,English", "-severity", "x=y", "./..."}
+	if err := c.presetFlags(args); err != nil {
+		t.Fatalf("presetFlags() error = %v", err)
+	}
+
+	if c.configPath != "loglint.json" || !c.noFix {
+		t.Fatalf("configPath = %q, noFix = %v", c.configPath, c.noFix)
+	}
+	if got := c.disable.String(); got != "lowercase,english" {
+		t.Fatalf("disable = %q", got)
+	}
+	if got := c.severity.String(); got != "x=y" {
+		t.Fatalf("severity = %q", got)
+	}
+}
+
+func TestOptionsFlagPrecedence(t *testing.T) {
+	t.Parallel()
+
+	cfg := config.Defaults()
+	cfg.DisabledRules = []string{"English", "keystyle"}
+	cfg.Severity = map[string]string{"lowercase": "off", "sensitive": "warning", "kvpairs": "info"}
+
+	tests := []struct {
+		name         string
+		args         []string
+		wantDisabled []string
+		wantSeverity map[string]string
+		wantTaint    bool
+	}{
+		{
+			name:         "config only",
+			wantDisabled: []string{"English", "keystyle"},
+			wantSeverity: map[string]string{"lowercase": "off", "sensitive": "warning", "kvpairs": "info"},
+		},
+		{
+			name:         "enable wins over config and disable",
+			args:         []string{"-enable", "english,lowercase", "-disable", "english,specialchars"},
+			wantDisabled: []string{"keystyle", "specialchars"},
+			wantSeverity: map[string]string{"sensitive": "warning", "kvpairs": "info"},
+		},
+		{
+			name:         "severity flag wins over config and enable",
+			args:         []string{"-enable", "lowercase", "-severity", "lowercase=info,sensitive=error"},
+			wantDisabled: []string{"English", "keystyle"},
+			wantSeverity: map[string]string{"lowercase": "info", "sensitive": "error", "kvpairs": "info"},
+		},
+		{
+			name:         "enable taint",
+			args:         []string{"-enable", "Taint"},
+			wantDisabled: []string{"English", "keystyle"},
+			wantSeverity: map[string]string{"lowercase": "off", "sensitive": "warning", "kvpairs": "info"},
+			wantTaint:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			c := newCLIAnalyzer()
+			if err := c.Flags.Parse(tt.args); err != nil {
+				t.Fatalf("parse flags: %v", err)
+			}
+
+			options := c.options(cfg)
+			if !reflect.DeepEqual(options.DisabledRules, tt.wantDisabled) {
+				t.Fatalf("DisabledRules = %v, want %v", options.DisabledRules, tt.wantDisabled)
+			}
+			if !reflect.DeepEqual(options.Severity, tt.wantSeverity) {
+				t.Fatalf("Severity = %v, want %v", options.Severity, tt.wantSeverity)
+			}
+			if options.Taint != tt.wantTaint {
+				t.Fatalf("Taint = %v, want %v", options.Taint, tt.wantTaint)
+			}
+		})
+	}
+}
@@ -0,0 +1,174 @@
+package main
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/victornechaev/loglint/pkg/loglint"
+	"golang.org/x/tools/go/analysis"
+)
+
+// inventory реализует команду `loglint inventory [-format json|csv] [-o path] [flags] [packages]`:
+// печатает каталог всех вызовов логгеров с текстом сообщений, уровнями и ключами атрибутов.
+// Логгеры, обертки и исключенные файлы берутся из конфига, как при обычном запуске.
+func inventory(args []string) int {
+	cli := newCLIAnalyzer()
+	flags := flag.NewFlagSet("loglint inventory", flag.ContinueOnError)
+	format := flags.String("format", "json", "output format: json or csv")
+	output := flags.String("o", "", "file to write (default: stdout)")
+	tests := flags.Bool("test", false, "indicates whether test files should be included, too")
+	cli.registerFlags(flags)
+	if err := flags.Parse(args); err != nil {
+		return 2
+	}
+
+	write, ok := inventoryWriters[*format]
+	if !ok {
+		fmt.Fprintf(os.Stderr, "unknown format %q\n", *format)
+		return 2
+	}
+
+	cfg, err := cli.config()
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 1
+	}
+
+	analyzer, err := loglint.NewInventory(cli.options(cfg))
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 1
+	}
+
+	calls, err := collectInventory(analyzer, flags.Args(), *tests)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 1
+	}
+
+	out := io.Writer(os.Stdout)
+	if *output != "" {
+		file, err := os.Create(*output)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			return 1
+		}
+		defer file.Close()
+		out = file
+	}
+
+	if err := write(out, calls); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 1
+	}
+
+	return 0
+}
+
+// collectInventory собирает вызовы логгеров всех пакетов.
+func collectInventory(analyzer *analysis.Analyzer, patterns []string, tests bool) ([]loglint.LogCall, error) {
+	graph, err := loadAndAnalyze(analyzer, patterns, tests)
+	if err != nil {
+		return nil, err
+	}
+
+	wd, err := os.Getwd()
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([][]loglint.LogCall, 0, len(graph.Roots))
+	for _, act := range graph.Roots {
+		if act.Err != nil {
+			return nil, act.Err
+		}
+		results = append(results, act.Result.([]loglint.LogCall))
+	}
+
+	return mergeInventory(results, wd), nil
+}
+
+// mergeInventory объединяет результаты пакетов в один отсортированный список. Пути файлов
+// внутри wd становятся относительными; вызовы из тестовых вариантов пакета не повторяются.
+func mergeInventory(results [][]loglint.LogCall, wd string) []loglint.LogCall {
+	var calls []loglint.LogCall
+	seen := make(map[string]bool)
+	for _, result := range results {
+		for _, call := range result {
+			if rel, err := filepath.Rel(wd, call.File); err == nil && !strings.HasPrefix(rel, "..") {
+				call.File = filepath.ToSlash(rel)
+			}
+
+			key := fmt.Sprintf("%s:%d:%d", call.File, call.Line, call.Column)
+			if seen[key] {
+				continue
+			}
+			seen[key] = true
+			calls = append(calls, call)
+		}
+	}
+
+	sort.SliceStable(calls, func(i, j int) bool {
+		if calls[i].File != calls[j].File {
+			return calls[i].File < calls[j].File
+		}
+		if calls[i].Line != calls[j].Line {
+			return calls[i].Line < calls[j].Line
+		}
+		return calls[i].Column < calls[j].Column
+	})
+
+	return calls
+}
+
+var inventoryWriters = map[string]func(io.Writer, []loglint.LogCall) error{
+	"json": writeInventoryJSON,
+	"csv":  writeInventoryCSV,
+}
+
+func writeInventoryJSON(w io.Writer, calls []loglint.LogCall) error {
+	if calls == nil {
+		calls = []loglint.LogCall{}
+	}
+
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(calls)
+}
+
+// writeInventoryCSV пишет по строке на вызов. Литеральные части динамического
+// сообщения разделяются "|", ключи атрибутов — ";".
+func writeInventoryCSV(w io.Writer, calls []loglint.LogCall) error {
+	writer := csv.NewWriter(w)
+	if err := writer.Write([]string{"file", "line", "column", "func", "library", "level", "message", "parts", "keys"}); err != nil {
+		return err
+	}
+
+	for _, call := range calls {
+		record := []string{
+			call.File,
+			strconv.Itoa(call.Line),
+			strconv.Itoa(call.Column),
+			call.Func,
+			call.Library,
+			call.Level,
+			call.Message,
+			strings.Join(call.Parts, "|"),
+			strings.Join(call.Keys, ";"),
+		}
+		if err := writer.Write(record); err != nil {
+			return err
+		}
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
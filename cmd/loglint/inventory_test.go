@@ -0,0 +1,129 @@
+package main
+
+import (
+	"bytes"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/victornechaev/loglint/pkg/loglint"
+)
+
+func TestMergeInventory(t *testing.T) {
+	t.Parallel()
+
+	wd := filepath.Join(t.TempDir(), "project")
+	inside := filepath.Join(wd, "app", "main.go")
+	outside := filepath.Join(filepath.Dir(wd), "other", "lib.go")
+
+	tests := []struct {
+		name    string
+		results [][]loglint.LogCall
+		want    []loglint.LogCall
+	}{
+		{
+			name:    "empty",
+			results: [][]loglint.LogCall{nil, {}},
+			want:    nil,
+		},
+		{
+			name: "relative paths inside wd only",
+			results: [][]loglint.LogCall{{
+				{File: outside, Line: 1, Column: 2},
+				{File: inside, Line: 3, Column: 4},
+			}},
+			want: []loglint.LogCall{
+				{File: outside, Line: 1, Column: 2},
+				{File: "app/main.go", Line: 3, Column: 4},
+			},
+		},
+		{
+			name: "test variant duplicates",
+			results: [][]loglint.LogCall{
+				{{File: inside, Line: 5, Column: 2, Message: "a"}, {File: inside, Line: 3, Column: 2, Message: "b"}},
+				{{File: inside, Line: 3, Column: 2, Message: "b"}, {File: inside, Line: 3, Column: 9, Message: "c"}},
+			},
+			want: []loglint.LogCall{
+				{File: "app/main.go", Line: 3, Column: 2, Message: "b"},
+				{File: "app/main.go", Line: 3, Column: 9, Message: "c"},
+				{File: "app/main.go", Line: 5, Column: 2, Message: "a"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := mergeInventory(tt.results, wd); !reflect.DeepEqual(got, tt.want) {
+				t.Fatalf("mergeInventory() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestInventoryWriters(t *testing.T) {
+	t.Parallel()
+
+	calls := []loglint.LogCall{
+		{
+			File: "app/main.go", Line: 3, Column: 2, Func: "Handler.Serve", Library: "slog", Level: "info",
+			Parts: []string{"user ", ", done"}, Keys: []string{"user_id", "status"},
+		},
+		{File: "app/main.go", Line: 7, Column: 2, Library: "zap", Message: `say "hi", bye`},
+	}
+
+	tests := []struct {
+		name   string
+		format string
+		calls  []loglint.LogCall
+		want   string
+	}{
+		{
+			name:   "csv",
+			format: "csv",
+			calls:  calls,
+			want: "file,line,column,func,library,level,message,parts,keys\n" +
+				"app/main.go,3,2,Handler.Serve,slog,info,,\"user |, done\",user_id;status\n" +
+				"app/main.go,7,2,,zap,,\"say \"\"hi\"\", bye\",,\n",
+		},
+		{
+			name:   "csv empty",
+			format: "csv",
+			want:   "file,line,column,func,library,level,message,parts,keys\n",
+		},
+		{
+			name:   "json",
+			format: "json",
+			calls:  calls[1:],
+			want: "[\n" +
+				"  {\n" +
+				"    \"file\": \"app/main.go\",\n" +
+				"    \"line\": 7,\n" +
+				"    \"column\": 2,\n" +
+				"    \"library\": \"zap\",\n" +
+				"    \"message\": \"say \\\"hi\\\", bye\"\n" +
+				"  }\n" +
+				"]\n",
+		},
+		{
+			name:   "json empty",
+			format: "json",
+			want:   "[]\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var out bytes.Buffer
+			if err := inventoryWriters[tt.format](&out, tt.calls); err != nil {
+				t.Fatalf("write %s: %v", tt.format, err)
+			}
+			if out.String() != tt.want {
+				t.Fatalf("output:\n%s\nwant:\n%s", out.String(), tt.want)
+			}
+		})
+	}
+}
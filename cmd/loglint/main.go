@@ -1,7 +1,9 @@
 package main
 
 import (
-	"log"
+	"fmt"
+	"os"
+	"strings"
 
 	"github.com/victornechaev/loglint/internal/config"
 	"github.com/victornechaev/loglint/pkg/loglint"
@@ -9,17 +11,145 @@ import (
 )
 
 func main() {
-	cfg, err := config.Load(config.DefaultPath)
+	if len(os.Args) > 1 && os.Args[1] == "inventory" {
+		os.Exit(inventory(os.Args[2:]))
+	}
+
+	if len(os.Args) > 2 {
+		switch os.Args[1] + " " + os.Args[2] {
+		case "config validate":
+			os.Exit(validateConfig(os.Args[3:]))
+		case "baseline write":
+			os.Exit(writeBaseline(os.Args[3:]))
+		}
+	}
+
+	analyzer := newCLIAnalyzer()
+	if needsSinglechecker(os.Args[1:], &analyzer.Flags) {
+		analyzer.prepare(os.Args[1:])
+		singlechecker.Main(analyzer.Analyzer)
+	}
+
+	os.Exit(lint(analyzer, os.Args[1:]))
+}
+
+// validateConfig реализует команду `loglint config validate [path]`: загружает конфиг
+// (по умолчанию найденный через config.Discover) вместе с вложенными, собирает по нему
+// анализатор и печатает все найденные ошибки.
+func validateConfig(args []string) int {
+	var path string
+	if len(args) > 0 {
+		path = args[0]
+	} else {
+		found, err := config.Discover(".")
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			return 1
+		}
+		path = found
+	}
+
+	if path == "" {
+		fmt.Fprintf(os.Stderr, "%s not found up to the module root\n", strings.Join(config.FileNames, ", "))
+		return 1
+	}
+
+	if _, err := os.Stat(path); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 1
+	}
+
+	cfg, err := config.LoadTree(path)
+	if err == nil {
+		_, err = loglint.NewAnalyzer(analyzerOptions(cfg))
+	}
 	if err != nil {
-		log.Fatal(err)
+		fmt.Fprintln(os.Stderr, err)
+		return 1
 	}
 
-	options := loglint.Options{
+	fmt.Printf("%s: ok\n", path)
+	return 0
+}
+
+func analyzerOptions(cfg config.Config) loglint.Options {
+	return loglint.Options{
 		SensitivePatterns: cfg.SensitivePatterns,
 		CustomPatterns:    cfg.CustomPatterns,
+		SensitiveTypes:    cfg.SensitiveTypes,
+		SecretDetectors:   secretDetectors(cfg.SecretDetectors),
+		PIIDetectors:      cfg.PIIDetectors,
+		RedactFunc:        cfg.RedactFunc,
 		DisabledRules:     cfg.DisabledRules,
 		DisableFixes:      !cfg.AutoFix,
+		Loggers:           loggerSpecs(cfg.Loggers),
+		KeyStyle:          cfg.KeyStyle,
+		Severity:          cfg.Severity,
+		Overrides:         overrideSpecs(cfg),
+		Root:              cfg.Dir,
+		ExcludePaths:      cfg.ExcludePaths,
+		LintGenerated:     cfg.LintGenerated,
+		Taint:             cfg.Taint.Enabled,
+		TaintSources:      taintSources(cfg.Taint.Sources),
+	}
+}
+
+// overrideSpecs переводит overrides из конфига; пути в них заданы относительно
+// каталога конфига или вложенного конфига, из которого пришло переопределение.
+func overrideSpecs(cfg config.Config) []loglint.Override {
+	specs := make([]loglint.Override, 0, len(cfg.Overrides))
+	for _, o := range cfg.Overrides {
+		root := o.Dir
+		if root == "" {
+			root = cfg.Dir
+		}
+
+		specs = append(specs, loglint.Override{
+			Root:              root,
+			Paths:             o.Paths,
+			Packages:          o.Packages,
+			DisabledRules:     o.DisabledRules,
+			SensitivePatterns: o.SensitivePatterns,
+			Severity:          o.Severity,
+		})
+	}
+
+	return specs
+}
+
+func loggerSpecs(loggers []config.Logger) []loglint.LoggerSpec {
+	specs := make([]loglint.LoggerSpec, 0, len(loggers))
+	for _, logger := range loggers {
+		specs = append(specs, loglint.LoggerSpec{
+			Package:      logger.Package,
+			Type:         logger.Type,
+			Methods:      logger.Methods,
+			MessageIndex: logger.MessageIndex,
+			Kind:         logger.Kind,
+		})
+	}
+
+	return specs
+}
+
+func taintSources(sources []config.TaintSource) []loglint.TaintSource {
+	specs := make([]loglint.TaintSource, 0, len(sources))
+	for _, source := range sources {
+		specs = append(specs, loglint.TaintSource(source))
+	}
+
+	return specs
+}
+
+func secretDetectors(detectors map[string]config.SecretDetector) map[string]loglint.SecretDetector {
+	specs := make(map[string]loglint.SecretDetector, len(detectors))
+	for name, detector := range detectors {
+		specs[name] = loglint.SecretDetector{
+			Disabled:   detector.Enabled != nil && !*detector.Enabled,
+			MinEntropy: detector.MinEntropy,
+			MinLength:  detector.MinLength,
+		}
 	}
 
-	singlechecker.Main(loglint.NewAnalyzer(options))
+	return specs
 }
@@ -0,0 +1,319 @@
+package main
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"go/token"
+	"io"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"unicode/utf16"
+	"unicode/utf8"
+
+	"github.com/victornechaev/loglint/internal/baseline"
+	"github.com/victornechaev/loglint/pkg/loglint"
+)
+
+// Отчет в формате SARIF 2.1.0: https://docs.oasis-open.org/sarif/sarif/v2.1.0/sarif-v2.1.0.html.
+// Описаны только используемые поля.
+
+const (
+	sarifSchema  = "https://json.schemastore.org/sarif-2.1.0.json"
+	sarifVersion = "2.1.0"
+	// sarifRootID — база относительных путей в отчете, каталог запуска линтера.
+	sarifRootID = "%SRCROOT%"
+	// sarifFingerprint — ключ стабильного отпечатка находки в partialFingerprints.
+	sarifFingerprint = "loglint/v1"
+)
+
+type sarifLog struct {
+	Schema  string     `json:"$schema"`
+	Version string     `json:"version"`
+	Runs    []sarifRun `json:"runs"`
+}
+
+type sarifRun struct {
+	Tool               sarifTool                   `json:"tool"`
+	OriginalURIBaseIDs map[string]sarifArtifactLoc `json:"originalUriBaseIds"`
+	Results            []sarifResult               `json:"results"`
+}
+
+type sarifTool struct {
+	Driver sarifDriver `json:"driver"`
+}
+
+type sarifDriver struct {
+	Name  string      `json:"name"`
+	Rules []sarifRule `json:"rules"`
+}
+
+type sarifRule struct {
+	ID                   string             `json:"id"`
+	ShortDescription     sarifText          `json:"shortDescription"`
+	FullDescription      sarifText          `json:"fullDescription"`
+	Help                 sarifText          `json:"help"`
+	DefaultConfiguration sarifConfiguration `json:"defaultConfiguration"`
+}
+
+type sarifConfiguration struct {
+	Level string `json:"level"`
+}
+
+type sarifText struct {
+	Text string `json:"text"`
+}
+
+type sarifResult struct {
+	RuleID              string            `json:"ruleId"`
+	RuleIndex           *int              `json:"ruleIndex,omitempty"`
+	Level               string            `json:"level"`
+	Message             sarifText         `json:"message"`
+	Locations           []sarifLocation   `json:"locations"`
+	PartialFingerprints map[string]string `json:"partialFingerprints"`
+	Fixes               []sarifFix        `json:"fixes,omitempty"`
+}
+
+type sarifLocation struct {
+	PhysicalLocation sarifPhysicalLocation `json:"physicalLocation"`
+}
+
+type sarifPhysicalLocation struct {
+	ArtifactLocation sarifArtifactLoc `json:"artifactLocation"`
+	Region           sarifRegion      `json:"region"`
+}
+
+type sarifArtifactLoc struct {
+	URI       string `json:"uri"`
+	URIBaseID string `json:"uriBaseId,omitempty"`
+}
+
+type sarifRegion struct {
+	StartLine   int `json:"startLine"`
+	StartColumn int `json:"startColumn"`
+	EndLine     int `json:"endLine,omitempty"`
+	EndColumn   int `json:"endColumn,omitempty"`
+}
+
+type sarifFix struct {
+	Description     sarifText             `json:"description"`
+	ArtifactChanges []sarifArtifactChange `json:"artifactChanges"`
+}
+
+type sarifArtifactChange struct {
+	ArtifactLocation sarifArtifactLoc   `json:"artifactLocation"`
+	Replacements     []sarifReplacement `json:"replacements"`
+}
+
+type sarifReplacement struct {
+	DeletedRegion   sarifRegion `json:"deletedRegion"`
+	InsertedContent sarifText   `json:"insertedContent"`
+}
+
+// sarifLevels переводит уровни важности loglint в уровни SARIF. Уровень off встречается
+// только в описаниях правил: у отключенного правила нет находок.
+var sarifLevels = map[string]string{
+	loglint.SeverityError:   "error",
+	loglint.SeverityWarning: "warning",
+	loglint.SeverityInfo:    "note",
+	loglint.SeverityOff:     "none",
+}
+
+// ruleSeverities возвращает уровни важности правил, заданные в options: из Severity и
+// DisabledRules. Правила без уровня имеют уровень error; overrides не учитываются.
+func ruleSeverities(options loglint.Options) map[string]string {
+	levels := make(map[string]string, len(options.Severity)+len(options.DisabledRules))
+	for rule, level := range options.Severity {
+		levels[strings.ToLower(strings.TrimSpace(rule))] = strings.ToLower(strings.TrimSpace(level))
+	}
+	for _, rule := range options.DisabledRules {
+		levels[strings.ToLower(strings.TrimSpace(rule))] = loglint.SeverityOff
+	}
+
+	return levels
+}
+
+// writeSARIF пишет находки в w. Пути файлов записываются относительно root, уровни правил
+// по умолчанию берутся из severities (см. ruleSeverities).
+func writeSARIF(w io.Writer, findings []finding, root string, severities map[string]string) error {
+	root, err := filepath.Abs(root)
+	if err != nil {
+		return err
+	}
+
+	report := &sarifReport{
+		paths:       &baseline.Baseline{Root: root},
+		sources:     make(map[string][]byte),
+		occurrences: make(map[string]int),
+	}
+
+	rules := loglint.Rules()
+	ruleIndex := make(map[string]int, len(rules))
+	driver := sarifDriver{Name: loglint.Analyzer.Name, Rules: make([]sarifRule, 0, len(rules))}
+	for i, rule := range rules {
+		ruleIndex[rule.Name] = i
+		level, ok := severities[rule.Name]
+		if !ok {
+			level = loglint.SeverityError
+		}
+		driver.Rules = append(driver.Rules, sarifRule{
+			ID:                   rule.Name,
+			ShortDescription:     sarifText{Text: rule.Short},
+			FullDescription:      sarifText{Text: rule.Full},
+			Help:                 sarifText{Text: rule.Help},
+			DefaultConfiguration: sarifConfiguration{Level: sarifLevels[level]},
+		})
+	}
+
+	results := make([]sarifResult, 0, len(findings))
+	for _, f := range findings {
+		rule, severity := loglint.SplitCategory(f.diag.Category)
+		// Для правила не из списка ruleIndex не указывается: -1 означает то же, но
+		// не все потребители SARIF его принимают.
+		var index *int
+		if i, ok := ruleIndex[rule]; ok {
+			index = &i
+		}
+
+		end := f.position
+		if f.diag.End.IsValid() {
+			end = f.pkg.Fset.Position(f.diag.End)
+		}
+
+		result := sarifResult{
+			RuleID:    rule,
+			RuleIndex: index,
+			Level:     sarifLevels[severity],
+			Message:   sarifText{Text: f.diag.Message},
+			Locations: []sarifLocation{{PhysicalLocation: sarifPhysicalLocation{
+				ArtifactLocation: report.artifact(f.position.Filename),
+				Region:           report.region(f.position, end),
+			}}},
+			PartialFingerprints: map[string]string{sarifFingerprint: report.fingerprint(f, rule)},
+		}
+
+		for _, fix := range f.diag.SuggestedFixes {
+			changes := make(map[string]*sarifArtifactChange)
+			var order []string
+			for _, edit := range fix.TextEdits {
+				start := f.pkg.Fset.Position(edit.Pos)
+				stop := start
+				if edit.End.IsValid() {
+					stop = f.pkg.Fset.Position(edit.End)
+				}
+
+				change, ok := changes[start.Filename]
+				if !ok {
+					change = &sarifArtifactChange{ArtifactLocation: report.artifact(start.Filename)}
+					changes[start.Filename] = change
+					order = append(order, start.Filename)
+				}
+				change.Replacements = append(change.Replacements, sarifReplacement{
+					DeletedRegion:   report.region(start, stop),
+					InsertedContent: sarifText{Text: string(edit.NewText)},
+				})
+			}
+
+			sarifFix := sarifFix{Description: sarifText{Text: fix.Message}}
+			for _, filename := range order {
+				sarifFix.ArtifactChanges = append(sarifFix.ArtifactChanges, *changes[filename])
+			}
+			result.Fixes = append(result.Fixes, sarifFix)
+		}
+
+		results = append(results, result)
+	}
+
+	log := sarifLog{
+		Schema:  sarifSchema,
+		Version: sarifVersion,
+		Runs: []sarifRun{{
+			Tool: sarifTool{Driver: driver},
+			OriginalURIBaseIDs: map[string]sarifArtifactLoc{
+				sarifRootID: {URI: fileURI(root) + "/"},
+			},
+			Results: results,
+		}},
+	}
+
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(log)
+}
+
+// sarifReport хранит состояние, общее для всех находок отчета.
+type sarifReport struct {
+	paths       *baseline.Baseline
+	sources     map[string][]byte
+	occurrences map[string]int
+}
+
+// artifact возвращает ссылку на файл: относительную к sarifRootID, если файл внутри
+// каталога запуска, и абсолютный file URI иначе.
+func (s *sarifReport) artifact(filename string) sarifArtifactLoc {
+	rel := s.paths.RelPath(filename)
+	if filepath.IsAbs(filepath.FromSlash(rel)) {
+		return sarifArtifactLoc{URI: fileURI(filename)}
+	}
+
+	return sarifArtifactLoc{URI: (&url.URL{Path: rel}).EscapedPath(), URIBaseID: sarifRootID}
+}
+
+// region переводит позиции go/token в область SARIF. Колонки в go/token считаются
+// в байтах, а в SARIF по умолчанию — в кодовых единицах UTF-16.
+func (s *sarifReport) region(start, end token.Position) sarifRegion {
+	return sarifRegion{
+		StartLine:   start.Line,
+		StartColumn: s.column(start),
+		EndLine:     end.Line,
+		EndColumn:   s.column(end),
+	}
+}
+
+func (s *sarifReport) column(pos token.Position) int {
+	src, ok := s.sources[pos.Filename]
+	if !ok {
+		// Файл без исходника (или недоступный) оставляет байтовую колонку.
+		src, _ = os.ReadFile(pos.Filename)
+		s.sources[pos.Filename] = src
+	}
+
+	lineStart := pos.Offset - (pos.Column - 1)
+	if lineStart < 0 || pos.Offset > len(src) {
+		return pos.Column
+	}
+
+	column := 1
+	for line := src[lineStart:pos.Offset]; len(line) > 0; {
+		r, size := utf8.DecodeRune(line)
+		column += len(utf16.Encode([]rune{r}))
+		line = line[size:]
+	}
+
+	return column
+}
+
+// fingerprint строит отпечаток, который не меняется при сдвиге строк: правило, файл,
+// объемлющая функция и текст находки, как в baseline, плюс номер повтора такой же
+// находки в файле.
+func (s *sarifReport) fingerprint(f finding, rule string) string {
+	fn := ""
+	if file := fileOf(f.pkg, f.diag.Pos); file != nil {
+		fn = baseline.EnclosingFunc(file, f.diag.Pos)
+	}
+
+	entry := s.paths.NewEntry(rule, f.position.Filename, fn, f.diag.Message)
+	key := strings.Join([]string{entry.Rule, entry.File, entry.Func, entry.Message}, "\x00")
+	occurrence := s.occurrences[key]
+	s.occurrences[key]++
+
+	sum := sha256.Sum256([]byte(key + "\x00" + strconv.Itoa(occurrence)))
+	return hex.EncodeToString(sum[:])
+}
+
+func fileURI(path string) string {
+	return (&url.URL{Scheme: "file", Path: filepath.ToSlash(path)}).String()
+}
@@ -0,0 +1,277 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/victornechaev/loglint/pkg/loglint"
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/packages"
+)
+
+// parsePackage записывает исходники в dir и разбирает их в пакет с общим FileSet.
+func parsePackage(t *testing.T, dir string, sources map[string]string) *packages.Package {
+	t.Helper()
+
+	pkg := &packages.Package{Fset: token.NewFileSet()}
+	for name, src := range sources {
+		path := filepath.Join(dir, name)
+		if err := os.WriteFile(path, []byte(src), 0o600); err != nil {
+			t.Fatalf("write %s: %v", name, err)
+		}
+
+		file, err := parser.ParseFile(pkg.Fset, path, src, parser.SkipObjectResolution)
+		if err != nil {
+			t.Fatalf("parse %s: %v", name, err)
+		}
+		pkg.Syntax = append(pkg.Syntax, file)
+	}
+
+	return pkg
+}
+
+// posOf возвращает позицию первого вхождения needle в файле name пакета.
+func posOf(t *testing.T, pkg *packages.Package, name, needle string) token.Pos {
+	t.Helper()
+
+	for _, file := range pkg.Syntax {
+		tokFile := pkg.Fset.File(file.Pos())
+		if filepath.Base(tokFile.Name()) != name {
+			continue
+		}
+
+		src, err := os.ReadFile(tokFile.Name())
+		if err != nil {
+			t.Fatal(err)
+		}
+		offset := bytes.Index(src, []byte(needle))
+		if offset < 0 {
+			t.Fatalf("%q not found in %s", needle, name)
+		}
+		return tokFile.Pos(offset)
+	}
+
+	t.Fatalf("file %s not parsed", name)
+	return token.NoPos
+}
+
+func newFinding(pkg *packages.Package, diag analysis.Diagnostic) finding {
+	return finding{diag: diag, pkg: pkg, position: pkg.Fset.Position(diag.Pos)}
+}
+
+// sarifRunOutput разбирает отчет writeSARIF в карты, чтобы проверять и отсутствующие поля,
+// и возвращает находки и описания правил.
+func sarifRunOutput(t *testing.T, findings []finding, root string, severities map[string]string) (results, rules []map[string]any) {
+	t.Helper()
+
+	var out bytes.Buffer
+	if err := writeSARIF(&out, findings, root, severities); err != nil {
+		t.Fatalf("writeSARIF() error = %v", err)
+	}
+
+	var log struct {
+		Runs []struct {
+			Tool struct {
+				Driver struct {
+					Rules []map[string]any `json:"rules"`
+				} `json:"driver"`
+			} `json:"tool"`
+			Results []map[string]any `json:"results"`
+		} `json:"runs"`
+	}
+	if err := json.Unmarshal(out.Bytes(), &log); err != nil {
+		t.Fatalf("unmarshal SARIF: %v\n%s", err, out.String())
+	}
+	if len(log.Runs) != 1 {
+		t.Fatalf("runs = %d, want 1", len(log.Runs))
+	}
+
+	return log.Runs[0].Results, log.Runs[0].Tool.Driver.Rules
+}
+
+// sarifOutput возвращает находки отчета writeSARIF при уровнях правил по умолчанию.
+func sarifOutput(t *testing.T, findings []finding, root string) []map[string]any {
+	t.Helper()
+
+	results, _ := sarifRunOutput(t, findings, root, nil)
+	return results
+}
+
+// field достает значение по пути из ключей объектов и индексов массивов.
+func field(t *testing.T, value any, path ...any) any {
+	t.Helper()
+
+	for _, step := range path {
+		switch key := step.(type) {
+		case string:
+			object, ok := value.(map[string]any)
+			if !ok {
+				t.Fatalf("%v: not an object at %q", path, key)
+			}
+			value = object[key]
+		case int:
+			array, ok := value.([]any)
+			if !ok || key >= len(array) {
+				t.Fatalf("%v: no element %d", path, key)
+			}
+			value = array[key]
+		}
+	}
+
+	return value
+}
+
+func TestSARIFColumnsAndRuleIndex(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	pkg := parsePackage(t, dir, map[string]string{
+		"main.go": "package main\n\nfunc f() {\n\tlog(\"привет 😀\", token)\n}\n",
+	})
+
+	tokenPos := posOf(t, pkg, "main.go", "token)")
+	findings := []finding{
+		newFinding(pkg, analysis.Diagnostic{Pos: tokenPos, End: tokenPos + 5, Category: "sensitive:warning", Message: "m"}),
+		newFinding(pkg, analysis.Diagnostic{Pos: tokenPos, Category: "custom:error", Message: "m"}),
+	}
+
+	results := sarifOutput(t, findings, dir)
+	if len(results) != 2 {
+		t.Fatalf("results = %d, want 2", len(results))
+	}
+
+	// Перед token: таб, `log("`, 6 кириллических букв (1 единица UTF-16 каждая), пробел,
+	// эмодзи (2 единицы), `", `.
+	region := field(t, results[0], "locations", 0, "physicalLocation", "region")
+	if got := field(t, region, "startColumn"); got != float64(1+5+6+1+2+3+1) {
+		t.Fatalf("startColumn = %v", got)
+	}
+	if got := field(t, region, "endColumn"); got != float64(1+5+6+1+2+3+1+5) {
+		t.Fatalf("endColumn = %v", got)
+	}
+	if got := field(t, results[0], "level"); got != "warning" {
+		t.Fatalf("level = %v", got)
+	}
+	if _, ok := results[0]["ruleIndex"]; !ok {
+		t.Fatalf("ruleIndex missing for a known rule")
+	}
+	if got := field(t, results[0], "locations", 0, "physicalLocation", "artifactLocation", "uri"); got != "main.go" {
+		t.Fatalf("uri = %v", got)
+	}
+
+	if index, ok := results[1]["ruleIndex"]; ok {
+		t.Fatalf("ruleIndex = %v for an unknown rule, want it omitted", index)
+	}
+}
+
+func TestSARIFFingerprints(t *testing.T) {
+	t.Parallel()
+
+	fingerprints := func(src string) []string {
+		dir := t.TempDir()
+		pkg := parsePackage(t, dir, map[string]string{"main.go": src})
+
+		var findings []finding
+		offset := 0
+		for {
+			idx := strings.Index(src[offset:], "log(")
+			if idx < 0 {
+				break
+			}
+			offset += idx + 1
+			pos := pkg.Fset.File(pkg.Syntax[0].Pos()).Pos(offset - 1)
+			findings = append(findings, newFinding(pkg, analysis.Diagnostic{Pos: pos, Category: "sensitive:error", Message: "same"}))
+		}
+
+		var result []string
+		for _, r := range sarifOutput(t, findings, dir) {
+			result = append(result, field(t, r, "partialFingerprints", sarifFingerprint).(string))
+		}
+		return result
+	}
+
+	before := fingerprints("package main\n\nfunc f() {\n\tlog(a)\n\tlog(a)\n}\n")
+	after := fingerprints("package main\n\n// shifted\n\nfunc f() {\n\n\tlog(a)\n\tlog(a)\n}\n")
+
+	if len(before) != 2 || before[0] == before[1] {
+		t.Fatalf("repeated findings must get distinct fingerprints: %v", before)
+	}
+	if strings.Join(before, ",") != strings.Join(after, ",") {
+		t.Fatalf("fingerprints changed after shifting lines:\n%v\n%v", before, after)
+	}
+}
+
+func TestSARIFFixesGroupedByFile(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	pkg := parsePackage(t, dir, map[string]string{
+		"a.go": "package main\n\nfunc a() { log(\"Token\") }\n",
+		"b.go": "package main\n\nimport \"fmt\"\n",
+	})
+
+	literal := posOf(t, pkg, "a.go", `"Token"`)
+	imports := posOf(t, pkg, "b.go", `"fmt"`)
+	fix := analysis.SuggestedFix{
+		Message: "fix",
+		TextEdits: []analysis.TextEdit{
+			{Pos: literal + 1, End: literal + 2, NewText: []byte("t")},
+			{Pos: imports, End: imports, NewText: []byte("x ")},
+			{Pos: literal + 6, End: literal + 6, NewText: []byte("!")},
+		},
+	}
+	results := sarifOutput(t, []finding{
+		newFinding(pkg, analysis.Diagnostic{Pos: literal, Category: "lowercase:error", Message: "m", SuggestedFixes: []analysis.SuggestedFix{fix}}),
+	}, dir)
+
+	changes, ok := field(t, results[0], "fixes", 0, "artifactChanges").([]any)
+	if !ok || len(changes) != 2 {
+		t.Fatalf("artifactChanges = %v, want one per file", changes)
+	}
+	if got := field(t, changes[0], "artifactLocation", "uri"); got != "a.go" {
+		t.Fatalf("first change uri = %v", got)
+	}
+	if got := len(field(t, changes[0], "replacements").([]any)); got != 2 {
+		t.Fatalf("a.go replacements = %d, want 2", got)
+	}
+	if got := field(t, changes[1], "replacements", 0, "insertedContent", "text"); got != "x " {
+		t.Fatalf("b.go inserted = %v", got)
+	}
+}
+
+func TestSARIFRuleDefaultLevels(t *testing.T) {
+	t.Parallel()
+
+	severities := ruleSeverities(loglint.Options{
+		Severity:      map[string]string{"Lowercase": "warning", "english": "Info", "kvpairs": "off"},
+		DisabledRules: []string{"keystyle"},
+	})
+	_, rules := sarifRunOutput(t, nil, t.TempDir(), severities)
+
+	want := map[string]string{
+		"lowercase": "warning",
+		"english":   "note",
+		"kvpairs":   "none",
+		"keystyle":  "none",
+		"sensitive": "error",
+	}
+	for _, rule := range rules {
+		level, ok := want[rule["id"].(string)]
+		if !ok {
+			continue
+		}
+		if got := field(t, rule, "defaultConfiguration", "level"); got != level {
+			t.Errorf("%s defaultConfiguration.level = %v, want %s", rule["id"], got, level)
+		}
+		delete(want, rule["id"].(string))
+	}
+	if len(want) > 0 {
+		t.Fatalf("rules missing from the report: %v", want)
+	}
+}
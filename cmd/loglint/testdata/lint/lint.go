@@ -0,0 +1,7 @@
+package lint
+
+import "log/slog"
+
+func run() {
+	slog.Info("Starting server")
+}
@@ -1,11 +1,17 @@
 package analyzer
 
 import (
+	"errors"
+	"fmt"
 	"go/ast"
-	"regexp"
+	"go/token"
+	"sort"
 	"strings"
 
+	"github.com/victornechaev/loglint/internal/baseline"
+	"github.com/victornechaev/loglint/internal/config"
 	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/analysis/passes/buildssa"
 )
 
 type Options struct {
@@ -13,54 +19,204 @@ type Options struct {
 	CustomPatterns    map[string]string
 	DisabledRules     []string
 	DisableFixes      bool
+	// SensitiveTypes — полные имена типов ("example.com/app/auth.Token"), значения которых
+	// нельзя выводить в лог целиком через slog.Any, zap.Any, хвост ...any или %v.
+	SensitiveTypes []string
+	// SecretDetectors настраивает встроенные детекторы секретов в тексте сообщений
+	// (ключи AWS, JWT, токены GitHub, PEM, строки с высокой энтропией) по их именам.
+	SecretDetectors map[string]SecretDetector
+	// PIIDetectors включает встроенные детекторы персональных данных с проверкой формата
+	// и контрольных сумм (номера карт, IBAN, email, телефоны, IP-адреса, национальные номера).
+	PIIDetectors []string
+	// RedactFunc — функция маскирования вида "example.com/app/redact.String": автоисправление
+	// правила sensitive оборачивает в нее чувствительное значение вместо того, чтобы убрать его
+	// из сообщения. Вызовы этой функции в сообщениях считаются безопасными.
+	RedactFunc string
+	// Loggers дополняет встроенный реестр логгеров пользовательскими методами.
+	Loggers []LoggerSpec
+	// KeyStyle задает стиль ключей атрибутов: snake_case, camelCase, kebab-case или dotted.
+	// Пустое значение отключает правило keystyle.
+	KeyStyle string
+	// Baseline — известные находки, о которых не нужно сообщать повторно.
+	Baseline *baseline.Baseline
+	// Severity задает уровень важности правил: error, warning, info или off.
+	// Правила без уровня сообщают об ошибках.
+	Severity map[string]string
+	// Overrides меняет настройки правил для отдельных каталогов, файлов и пакетов.
+	Overrides []Override
+	// Root — каталог, относительно которого заданы ExcludePaths; пустой — текущий каталог.
+	Root string
+	// ExcludePaths — glob-шаблоны файлов, которые не проверяются (синтаксис как у Override.Paths).
+	ExcludePaths []string
+	// LintGenerated включает проверку файлов с заголовком "// Code generated ... DO NOT EDIT.".
+	LintGenerated bool
+	// Taint включает правило taint: SSA-анализ потоков секретов из источников
+	// (заголовки HTTP, переменные окружения, DSN) в аргументы логгеров.
+	Taint bool
+	// TaintSources дополняет встроенные источники секретов правила taint.
+	TaintSources []TaintSource
 }
 
 type runner struct {
-	sensitivePatterns []string
-	customPatterns    []*regexp.Regexp
+	sensitivePatterns []sensitivePattern
+	customPatterns    []customPattern
+	sensitiveTypes    map[string]struct{}
+	secretDetectors   []secretDetector
+	piiDetectors      []piiDetector
+	redact            redactFunc
 	disabledRules     map[string]struct{}
 	disableFixes      bool
+	loggers           map[loggerKey]loggerMethod
+	keyStyle          string
+	baseline          *baseline.Baseline
+	severities        map[string]string
+	overrides         []override
+	root              string
+	excludePaths      []string
+	lintGenerated     bool
+	taintEnabled      bool
+	taint             taintSources
 }
 
-func New(options Options) *analysis.Analyzer {
-	patterns := defaultSensitivePatterns()
-	for _, p := range options.SensitivePatterns {
-		trimmed := strings.TrimSpace(p)
-		if trimmed == "" {
-			continue
-		}
-		patterns = append(patterns, trimmed)
+// New создает анализатор с заданными опциями. Некорректные regex-паттерны,
+// описания логгеров и стиль ключей возвращаются ошибкой, а не отбрасываются молча.
+func New(options Options) (*analysis.Analyzer, error) {
+	r, err := newRunner(options)
+	if err != nil {
+		return nil, err
 	}
 
-	r := &runner{
-		sensitivePatterns: normalizePatterns(patterns),
-		customPatterns:    compilePatterns(options.CustomPatterns),
-		disabledRules:     normalizeDisabledRules(options.DisabledRules),
-		disableFixes:      options.DisableFixes,
-	}
-
-	return &analysis.Analyzer{
+	analyzer := &analysis.Analyzer{
 		Name: "loglint",
-		Doc:  "checks slog and zap log messages for style and security issues",
+		Doc:  "checks log messages of slog, zap, logrus, zerolog and configured loggers for style and security issues",
 		Run:  r.run,
+		FactTypes: []analysis.Fact{
+			new(loggerWrapperFact),
+			new(taintFact),
+		},
+	}
+	// SSA строится только для правила taint: для остальных правил хватает AST.
+	if r.taintEnabled {
+		analyzer.Requires = []*analysis.Analyzer{buildssa.Analyzer}
 	}
+
+	return analyzer, nil
 }
 
-func compilePatterns(patterns map[string]string) []*regexp.Regexp {
+func newRunner(options Options) (*runner, error) {
+	customPatterns, err := compilePatterns(options.CustomPatterns)
+	if err != nil {
+		return nil, err
+	}
+
+	loggers, err := buildRegistry(options.Loggers)
+	if err != nil {
+		return nil, err
+	}
+
+	// Стиль сравнивается по тем же правилам, что и при проверке конфига.
+	keyStyle, ok := config.NormalizeKeyStyle(options.KeyStyle)
+	if !ok {
+		return nil, fmt.Errorf("unknown key style %q", options.KeyStyle)
+	}
+
+	severities, err := normalizeSeverities(options.Severity)
+	if err != nil {
+		return nil, err
+	}
+
+	overrides, err := compileOverrides(options.Overrides)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := validateGlobs(options.ExcludePaths); err != nil {
+		return nil, fmt.Errorf("exclude %w", err)
+	}
+
+	root, err := absRoot(options.Root)
+	if err != nil {
+		return nil, err
+	}
+
+	taint, err := compileTaintSources(options.TaintSources)
+	if err != nil {
+		return nil, err
+	}
+
+	sensitiveTypes, err := compileSensitiveTypes(options.SensitiveTypes)
+	if err != nil {
+		return nil, err
+	}
+
+	secretDetectors, err := compileSecretDetectors(options.SecretDetectors)
+	if err != nil {
+		return nil, err
+	}
+
+	piiDetectors, err := compilePIIDetectors(options.PIIDetectors)
+	if err != nil {
+		return nil, err
+	}
+
+	redact, err := parseRedactFunc(options.RedactFunc)
+	if err != nil {
+		return nil, err
+	}
+
+	return &runner{
+		sensitivePatterns: buildSensitivePatterns(options.SensitivePatterns),
+		customPatterns:    customPatterns,
+		sensitiveTypes:    sensitiveTypes,
+		secretDetectors:   secretDetectors,
+		piiDetectors:      piiDetectors,
+		redact:            redact,
+		disabledRules:     normalizeDisabledRules(options.DisabledRules),
+		disableFixes:      options.DisableFixes,
+		loggers:           loggers,
+		keyStyle:          keyStyle,
+		baseline:          options.Baseline,
+		severities:        severities,
+		overrides:         overrides,
+		root:              root,
+		excludePaths:      options.ExcludePaths,
+		lintGenerated:     options.LintGenerated,
+		taintEnabled:      options.Taint,
+		taint:             taint,
+	}, nil
+}
+
+// compilePatterns компилирует все пользовательские паттерны в порядке их имен,
+// чтобы ошибки и результаты не зависели от порядка обхода карты.
+func compilePatterns(patterns map[string]string) ([]customPattern, error) {
 	if len(patterns) == 0 {
-		return nil
+		return nil, nil
+	}
+
+	names := make([]string, 0, len(patterns))
+	for name := range patterns {
+		names = append(names, name)
 	}
+	sort.Strings(names)
 
-	result := make([]*regexp.Regexp, 0, len(patterns))
-	for _, pattern := range patterns {
-		re, err := regexp.Compile(pattern)
+	var (
+		result = make([]customPattern, 0, len(patterns))
+		errs   []error
+	)
+	for _, name := range names {
+		re, err := config.CompilePattern(name, patterns[name])
 		if err != nil {
+			errs = append(errs, err)
 			continue
 		}
-		result = append(result, re)
+		result = append(result, customPattern{name: name, re: re})
 	}
 
-	return result
+	if err := errors.Join(errs...); err != nil {
+		return nil, err
+	}
+
+	return result, nil
 }
 
 func normalizeDisabledRules(rules []string) map[string]struct{} {
@@ -81,8 +237,8 @@ func normalizeDisabledRules(rules []string) map[string]struct{} {
 }
 
 func (r *runner) ruleEnabled(rule string) bool {
-	if len(r.disabledRules) == 0 {
-		return true
+	if rule == ruleTaint && !r.taintEnabled || r.severity(rule) == SeverityOff {
+		return false
 	}
 
 	_, disabled := r.disabledRules[rule]
@@ -90,26 +246,68 @@ func (r *runner) ruleEnabled(rule string) bool {
 }
 
 func (r *runner) run(pass *analysis.Pass) (any, error) {
-	for _, file := range pass.Files {
-		ast.Inspect(file, func(node ast.Node) bool {
-			call, ok := node.(*ast.CallExpr)
-			if !ok {
-				return true
-			}
-
-			msgExpr, ok := extractMessageExpr(pass, call)
-			if !ok {
-				return true
-			}
-
-			if !isStringExpr(pass, msgExpr) {
-				return true
-			}
-
-			r.checkMessage(pass, msgExpr)
-			return true
+	r.detectWrappers(pass)
+
+	files := r.filesToCheck(pass)
+	matcher := newBaselineMatcher(pass, files, r.baseline)
+	defer r.reportStaleBaseline(pass, matcher)
+
+	var leaks map[*token.File][]taintLeak
+	if r.taintEnabled {
+		leaks = r.taintLeaks(pass)
+	}
+
+	for _, file := range files {
+		fr := r.forFile(pass, file)
+		directives := parseDirectives(pass.Fset, file)
+		filePass := withSuppression(pass, func(diag analysis.Diagnostic) bool {
+			return directives.suppress(pass.Fset, diag) || matcher.suppress(diag)
 		})
+
+		fr.checkFile(filePass, file)
+		fr.reportTaint(filePass, file, leaks)
+		fr.reportDirectiveProblems(pass, directives)
 	}
 
 	return nil, nil
 }
+
+func (r *runner) checkFile(pass *analysis.Pass, file *ast.File) {
+	ast.Inspect(file, func(node ast.Node) bool {
+		call, ok := node.(*ast.CallExpr)
+		if !ok {
+			return true
+		}
+
+		attrs := r.collectAttrs(pass, call)
+		r.checkAttrs(pass, attrs)
+		r.checkKeyStyle(pass, attrs)
+		r.checkKeyValuePairs(pass, call)
+
+		msg, ok := r.extractMessageExpr(pass, call)
+		r.checkSensitiveTypes(pass, attrs, msg, ok)
+		if !ok {
+			return true
+		}
+
+		if !isStringExpr(pass, msg.args[0]) {
+			return true
+		}
+
+		r.checkMessage(pass, msg)
+		return true
+	})
+}
+
+// withSuppression возвращает копию pass, которая отбрасывает диагностики,
+// подавленные директивами или baseline. Правило берется из категории диагностики (см. runner.report).
+func withSuppression(pass *analysis.Pass, suppressed func(analysis.Diagnostic) bool) *analysis.Pass {
+	filtered := *pass
+	filtered.Report = func(diag analysis.Diagnostic) {
+		if !suppressed(diag) {
+			pass.Report(diag)
+		}
+	}
+
+	return &filtered
+}
@@ -0,0 +1,224 @@
+package analyzer
+
+import (
+	"go/ast"
+	"go/types"
+
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/types/typeutil"
+)
+
+// logAttr — структурированный атрибут лог-записи: ключ и значение.
+type logAttr struct {
+	key   ast.Expr
+	value ast.Expr
+	// ready помечает готовый атрибут (slog.Attr, zap.Field), переданный в хвост пар.
+	// Его ключ проверяется при обходе самого конструктора.
+	ready bool
+}
+
+// attrForm описывает, в каком виде метод принимает атрибуты.
+type attrForm int
+
+const (
+	// attrPairs — чередующиеся ключи и значения (...any) по правилам log/slog.
+	attrPairs attrForm = iota
+	// attrKeyValue — один ключ и следующее за ним значение.
+	attrKeyValue
+	// attrKey — только имя (например, имя группы в slog.Logger.WithGroup).
+	attrKey
+	// attrFieldsMap — литерал карты полей вида logrus.Fields{"k": v}.
+	attrFieldsMap
+)
+
+type attrArgs struct {
+	index int
+	form  attrForm
+}
+
+// attrMethods — методы, которые принимают атрибуты отдельно от сообщения.
+var attrMethods = map[loggerKey]attrArgs{
+	{pkg: "log/slog", method: "Group"}:                                       {index: 1, form: attrPairs},
+	{pkg: "log/slog", typ: "Logger", method: "WithGroup"}:                    {index: 0, form: attrKey},
+	{pkg: "github.com/sirupsen/logrus", method: "WithField"}:                 {index: 0, form: attrKeyValue},
+	{pkg: "github.com/sirupsen/logrus", typ: "Logger", method: "WithField"}:  {index: 0, form: attrKeyValue},
+	{pkg: "github.com/sirupsen/logrus", typ: "Entry", method: "WithField"}:   {index: 0, form: attrKeyValue},
+	{pkg: "github.com/sirupsen/logrus", method: "WithFields"}:                {index: 0, form: attrFieldsMap},
+	{pkg: "github.com/sirupsen/logrus", typ: "Logger", method: "WithFields"}: {index: 0, form: attrFieldsMap},
+	{pkg: "github.com/sirupsen/logrus", typ: "Entry", method: "WithFields"}:  {index: 0, form: attrFieldsMap},
+}
+
+// collectAttrs возвращает атрибуты, которые вызов добавляет к лог-записи:
+// конструкторы slog.Attr и zap.Field, хвост ключ/значение key-value методов логгеров,
+// аргументы With/WithGroup/WithField и поля цепочек zerolog.
+func (r *runner) collectAttrs(pass *analysis.Pass, call *ast.CallExpr) []logAttr {
+	if fields := zerologEventFields(pass, call); len(fields) > 0 {
+		return fields
+	}
+
+	if tail, ok := r.extractKeyValueArgs(pass, call); ok {
+		return withoutReady(keyValuePairs(pass, tail))
+	}
+
+	fn, ok := typeutil.Callee(pass.TypesInfo, call).(*types.Func)
+	if !ok {
+		return nil
+	}
+
+	var attrs []logAttr
+	if attr, ok := attrConstructor(pass, call); ok {
+		attrs = append(attrs, attr)
+	}
+
+	if key, ok := calleeKey(fn); ok {
+		if args, ok := attrMethods[key]; ok {
+			// slog.Group одновременно является конструктором и принимает вложенные пары.
+			attrs = append(attrs, withoutReady(attrsFromArgs(pass, call, args))...)
+		}
+	}
+
+	return attrs
+}
+
+func withoutReady(attrs []logAttr) []logAttr {
+	result := attrs[:0]
+	for _, attr := range attrs {
+		if !attr.ready {
+			result = append(result, attr)
+		}
+	}
+
+	return result
+}
+
+func attrsFromArgs(pass *analysis.Pass, call *ast.CallExpr, args attrArgs) []logAttr {
+	if args.index >= len(call.Args) {
+		return nil
+	}
+
+	tail := call.Args[args.index:]
+	switch args.form {
+	case attrPairs:
+		// Хвост, переданный как args..., статически не разобрать.
+		if call.Ellipsis.IsValid() {
+			return nil
+		}
+		return keyValuePairs(pass, tail)
+	case attrKeyValue:
+		attr := logAttr{key: tail[0]}
+		if len(tail) > 1 {
+			attr.value = tail[1]
+		}
+		return []logAttr{attr}
+	case attrKey:
+		return []logAttr{{key: tail[0]}}
+	case attrFieldsMap:
+		return fieldsMapAttrs(tail[0])
+	}
+
+	return nil
+}
+
+// keyValuePairs разбирает чередующиеся ключи и значения по правилам log/slog:
+// готовый атрибут (slog.Attr, zap.Field) занимает одну позицию,
+// остальные аргументы считаются ключом, за которым следует значение.
+func keyValuePairs(pass *analysis.Pass, args []ast.Expr) []logAttr {
+	var attrs []logAttr
+	for i := 0; i < len(args); i++ {
+		if isAttrType(pass.TypesInfo.TypeOf(args[i])) {
+			attr := logAttr{ready: true}
+			if call, ok := ast.Unparen(args[i]).(*ast.CallExpr); ok {
+				if constructed, ok := attrConstructor(pass, call); ok {
+					attr.key = constructed.key
+				}
+			}
+			attrs = append(attrs, attr)
+			continue
+		}
+
+		attr := logAttr{key: args[i]}
+		if i+1 < len(args) {
+			attr.value = args[i+1]
+			i++
+		}
+		attrs = append(attrs, attr)
+	}
+
+	return attrs
+}
+
+func fieldsMapAttrs(expr ast.Expr) []logAttr {
+	lit, ok := ast.Unparen(expr).(*ast.CompositeLit)
+	if !ok {
+		return nil
+	}
+
+	attrs := make([]logAttr, 0, len(lit.Elts))
+	for _, elt := range lit.Elts {
+		if kv, ok := elt.(*ast.KeyValueExpr); ok {
+			attrs = append(attrs, logAttr{key: kv.Key, value: kv.Value})
+		}
+	}
+
+	return attrs
+}
+
+// attrConstructor распознает конструкторы атрибутов пакетного уровня:
+// slog.String("key", v), slog.Any(...), zap.String("key", v), zap.Int(...) и т.д.
+func attrConstructor(pass *analysis.Pass, call *ast.CallExpr) (logAttr, bool) {
+	fn, ok := typeutil.Callee(pass.TypesInfo, call).(*types.Func)
+	if !ok || fn.Pkg() == nil {
+		return logAttr{}, false
+	}
+
+	switch fn.Pkg().Path() {
+	case "log/slog", "go.uber.org/zap":
+	default:
+		return logAttr{}, false
+	}
+
+	sig, ok := fn.Type().(*types.Signature)
+	if !ok || sig.Recv() != nil || sig.Results().Len() != 1 || !isAttrType(sig.Results().At(0).Type()) {
+		return logAttr{}, false
+	}
+
+	return keyedAttr(pass, call)
+}
+
+// keyedAttr возвращает атрибут вызова, первый параметр которого — ключ атрибута
+// (slog.String(key, value string), zap.Int(key string, val int), Event.Str(key, val string)).
+func keyedAttr(pass *analysis.Pass, call *ast.CallExpr) (logAttr, bool) {
+	sig, ok := pass.TypesInfo.TypeOf(call.Fun).(*types.Signature)
+	if !ok || sig.Params().Len() == 0 || len(call.Args) == 0 {
+		return logAttr{}, false
+	}
+
+	first := sig.Params().At(0)
+	if first.Name() != "key" || !types.Identical(first.Type(), types.Typ[types.String]) {
+		return logAttr{}, false
+	}
+
+	attr := logAttr{key: call.Args[0]}
+	if len(call.Args) > 1 && !sig.Variadic() {
+		attr.value = call.Args[1]
+	}
+
+	return attr, true
+}
+
+// isAttrType сообщает, является ли тип готовым атрибутом: slog.Attr или zap.Field.
+func isAttrType(typ types.Type) bool {
+	named := namedType(typ)
+	if named == nil || named.Obj() == nil || named.Obj().Pkg() == nil {
+		return false
+	}
+
+	switch named.Obj().Pkg().Path() {
+	case "log/slog":
+		return named.Obj().Name() == "Attr"
+	case "go.uber.org/zap", "go.uber.org/zap/zapcore":
+		return named.Obj().Name() == "Field"
+	}
+
+	return false
+}
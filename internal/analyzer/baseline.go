@@ -0,0 +1,160 @@
+package analyzer
+
+import (
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/token"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/victornechaev/loglint/internal/baseline"
+	"golang.org/x/tools/go/analysis"
+)
+
+// baselineMatcher подавляет диагностики пакета, записанные в baseline,
+// и находит записи, которые больше не воспроизводятся.
+type baselineMatcher struct {
+	base *baseline.Baseline
+	fset *token.FileSet
+	// files — файлы пакета по пути относительно каталога baseline.
+	files map[string]*ast.File
+	// remaining — еще не сопоставленные записи для файлов пакета с числом повторов.
+	remaining map[baseline.Entry]int
+	// missing — записи для файлов каталога пакета, которых больше нет: файл удален или
+	// перенесен, и ни один пакет их уже не сопоставит.
+	missing []baseline.Entry
+}
+
+func newBaselineMatcher(pass *analysis.Pass, files []*ast.File, base *baseline.Baseline) *baselineMatcher {
+	if base == nil {
+		return nil
+	}
+
+	m := &baselineMatcher{
+		base:      base,
+		fset:      pass.Fset,
+		files:     make(map[string]*ast.File, len(files)),
+		remaining: make(map[baseline.Entry]int),
+	}
+	for _, file := range files {
+		m.files[base.RelPath(pass.Fset.File(file.Pos()).Name())] = file
+	}
+	for _, entry := range base.Entries {
+		if _, ok := m.files[entry.File]; ok {
+			m.remaining[entry]++
+		}
+	}
+	m.missing = missingFileEntries(pass, base)
+
+	return m
+}
+
+// missingFileEntries возвращает записи для несуществующих файлов каталога пакета.
+// Внешний тестовый пакет (package foo_test) лежит в том же каталоге, поэтому такие
+// записи сообщает только основной пакет.
+func missingFileEntries(pass *analysis.Pass, base *baseline.Baseline) []baseline.Entry {
+	if len(pass.Files) == 0 || strings.HasSuffix(pass.Pkg.Name(), "_test") {
+		return nil
+	}
+
+	dir := filepath.Dir(pass.Fset.File(pass.Files[0].Pos()).Name())
+	var missing []baseline.Entry
+	for _, entry := range base.Entries {
+		path := filepath.FromSlash(entry.File)
+		if !filepath.IsAbs(path) {
+			path = filepath.Join(base.Root, path)
+		}
+		if filepath.Dir(path) != dir {
+			continue
+		}
+
+		if _, err := os.Stat(path); errors.Is(err, os.ErrNotExist) {
+			missing = append(missing, entry)
+		}
+	}
+
+	return missing
+}
+
+// suppress сообщает, записана ли диагностика в baseline. Каждая запись
+// подавляет столько диагностик, сколько раз она повторяется в файле baseline.
+func (m *baselineMatcher) suppress(diag analysis.Diagnostic) bool {
+	if m == nil {
+		return false
+	}
+
+	tokenFile := m.fset.File(diag.Pos)
+	if tokenFile == nil {
+		return false
+	}
+
+	file, ok := m.files[m.base.RelPath(tokenFile.Name())]
+	if !ok {
+		return false
+	}
+
+	rule, _ := SplitCategory(diag.Category)
+	entry := m.base.NewEntry(rule, tokenFile.Name(), baseline.EnclosingFunc(file, diag.Pos), diag.Message)
+	if m.remaining[entry] == 0 {
+		return false
+	}
+
+	m.remaining[entry]--
+	return true
+}
+
+// reportStaleBaseline сообщает о записях baseline, которые больше не воспроизводятся:
+// их стоит удалить, перезаписав baseline.
+func (r *runner) reportStaleBaseline(pass *analysis.Pass, m *baselineMatcher) {
+	if m == nil {
+		return
+	}
+
+	var stale []baseline.Entry
+	for entry, count := range m.remaining {
+		if count > 0 {
+			stale = append(stale, entry)
+		}
+	}
+	sort.Slice(stale, func(i, j int) bool {
+		if stale[i].Func != stale[j].Func {
+			return stale[i].Func < stale[j].Func
+		}
+		return stale[i].Message < stale[j].Message
+	})
+
+	for _, entry := range stale {
+		file := m.files[entry.File]
+		pos, end := staleEntryRange(file, entry.Func)
+		r.report(pass, ruleBaseline, analysis.Diagnostic{
+			Pos:     pos,
+			End:     end,
+			Message: fmt.Sprintf("stale baseline entry: %s finding %q is no longer reported", entry.Rule, entry.Message),
+		})
+	}
+
+	// Записи удаленных файлов указывают на имя пакета в первом файле.
+	for _, entry := range m.missing {
+		file := pass.Files[0]
+		r.report(pass, ruleBaseline, analysis.Diagnostic{
+			Pos:     file.Name.Pos(),
+			End:     file.Name.End(),
+			Message: fmt.Sprintf("stale baseline entry: %s finding %q in %s: the file no longer exists", entry.Rule, entry.Message, entry.File),
+		})
+	}
+}
+
+// staleEntryRange указывает на объявление функции из записи, а если ее больше
+// нет — на имя пакета.
+func staleEntryRange(file *ast.File, fn string) (token.Pos, token.Pos) {
+	for _, decl := range file.Decls {
+		if decl, ok := decl.(*ast.FuncDecl); ok && fn != "" && baseline.EnclosingFunc(file, decl.Pos()) == fn {
+			return decl.Name.Pos(), decl.Name.End()
+		}
+	}
+
+	return file.Name.Pos(), file.Name.End()
+}
@@ -0,0 +1,184 @@
+package analyzer
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+	"strings"
+
+	"golang.org/x/tools/go/analysis"
+)
+
+// Директивы подавления диагностик:
+//
+//	slog.Info("Legacy message") //loglint:ignore lowercase,specialchars keeps the wire format
+//
+//	//loglint:file-ignore sensitive fixtures with fake credentials
+//
+// Строчная директива действует на свою строку, а если стоит на отдельной строке —
+// на следующую. Файловая директива действует на весь файл. Причина обязательна.
+const (
+	directivePrefix     = "//loglint:"
+	directiveIgnore     = "ignore"
+	directiveFileIgnore = "file-ignore"
+)
+
+type directive struct {
+	pos      token.Pos
+	end      token.Pos
+	filename string
+	// line — строка, на которую действует директива; 0 для file-ignore.
+	line  int
+	rules []string
+	// used отмечает правила, диагностики которых директива подавила.
+	used map[string]bool
+}
+
+func (d *directive) covers(filename string, line int, rule string) bool {
+	if d.filename != filename || d.line != 0 && d.line != line {
+		return false
+	}
+
+	for _, r := range d.rules {
+		if r == rule {
+			return true
+		}
+	}
+
+	return false
+}
+
+// directiveSet — директивы файла и ошибки их разбора.
+type directiveSet struct {
+	items     []*directive
+	malformed []analysis.Diagnostic
+}
+
+func parseDirectives(fset *token.FileSet, file *ast.File) *directiveSet {
+	set := &directiveSet{}
+	var codeLines map[int]bool
+	for _, group := range file.Comments {
+		for _, comment := range group.List {
+			if !strings.HasPrefix(comment.Text, directivePrefix) {
+				continue
+			}
+
+			if codeLines == nil {
+				codeLines = linesWithCode(fset, file)
+			}
+
+			d, err := parseDirective(fset, comment, codeLines)
+			if err != nil {
+				set.malformed = append(set.malformed, analysis.Diagnostic{
+					Pos:     comment.Pos(),
+					End:     comment.End(),
+					Message: "malformed loglint directive: " + err.Error(),
+				})
+				continue
+			}
+			set.items = append(set.items, d)
+		}
+	}
+
+	return set
+}
+
+func parseDirective(fset *token.FileSet, comment *ast.Comment, codeLines map[int]bool) (*directive, error) {
+	name, rest, _ := strings.Cut(strings.TrimPrefix(comment.Text, directivePrefix), " ")
+	if name != directiveIgnore && name != directiveFileIgnore {
+		return nil, fmt.Errorf("unknown directive %q", name)
+	}
+
+	list, reason, _ := strings.Cut(strings.TrimSpace(rest), " ")
+	if list == "" {
+		return nil, fmt.Errorf("%s requires a rule list", name)
+	}
+
+	var rules []string
+	for _, rule := range strings.Split(list, ",") {
+		rule = strings.ToLower(strings.TrimSpace(rule))
+		if !isKnownRule(rule) {
+			return nil, fmt.Errorf("unknown rule %q", rule)
+		}
+		rules = append(rules, rule)
+	}
+
+	if strings.TrimSpace(reason) == "" {
+		return nil, fmt.Errorf("%s requires a reason", name)
+	}
+
+	position := fset.Position(comment.Pos())
+	d := &directive{
+		pos:      comment.Pos(),
+		end:      comment.End(),
+		filename: position.Filename,
+		rules:    rules,
+		used:     make(map[string]bool, len(rules)),
+	}
+
+	if name == directiveIgnore {
+		d.line = position.Line
+		if !codeLines[position.Line] {
+			// Директива на отдельной строке относится к следующей строке.
+			d.line++
+		}
+	}
+
+	return d, nil
+}
+
+// linesWithCode возвращает строки файла, на которых заканчивается код.
+// Комментарий на такой строке стоит после кода, а не на отдельной строке.
+func linesWithCode(fset *token.FileSet, file *ast.File) map[int]bool {
+	lines := make(map[int]bool)
+	ast.Inspect(file, func(node ast.Node) bool {
+		switch node.(type) {
+		case nil, *ast.File, *ast.CommentGroup, *ast.Comment:
+			return true
+		}
+
+		lines[fset.Position(node.End()).Line] = true
+		return true
+	})
+
+	return lines
+}
+
+// suppress сообщает, подавлена ли диагностика, и отмечает сработавшие директивы.
+// Правило диагностики берется из ее категории.
+func (s *directiveSet) suppress(fset *token.FileSet, diag analysis.Diagnostic) bool {
+	position := fset.Position(diag.Pos)
+	rule, _ := SplitCategory(diag.Category)
+
+	suppressed := false
+	for _, d := range s.items {
+		if d.covers(position.Filename, position.Line, rule) {
+			d.used[rule] = true
+			suppressed = true
+		}
+	}
+
+	return suppressed
+}
+
+// reportDirectiveProblems сообщает о некорректных директивах и о правилах в директивах,
+// которые ничего не подавили. Правила, отключенные в конфиге, не учитываются.
+func (r *runner) reportDirectiveProblems(pass *analysis.Pass, set *directiveSet) {
+	for _, diag := range set.malformed {
+		r.report(pass, ruleDirectives, diag)
+	}
+
+	for _, d := range set.items {
+		for _, rule := range d.rules {
+			if d.used[rule] || !r.ruleEnabled(rule) {
+				continue
+			}
+
+			r.report(pass, ruleDirectives, analysis.Diagnostic{
+				Pos:     d.pos,
+				End:     d.end,
+				Message: fmt.Sprintf("unused loglint directive for rule %q", rule),
+			})
+		}
+	}
+}
@@ -0,0 +1,68 @@
+package analyzer
+
+import (
+	"fmt"
+	"go/ast"
+	"path"
+	"path/filepath"
+
+	"golang.org/x/tools/go/analysis"
+)
+
+// validateGlobs проверяет синтаксис glob-шаблонов путей.
+func validateGlobs(patterns []string) error {
+	for _, pattern := range patterns {
+		if _, err := path.Match(pattern, ""); err != nil {
+			return fmt.Errorf("path %q: %w", pattern, err)
+		}
+	}
+
+	return nil
+}
+
+// absRoot возвращает абсолютный путь каталога; пустой root — текущий каталог.
+func absRoot(root string) (string, error) {
+	if root == "" {
+		root = "."
+	}
+
+	return filepath.Abs(root)
+}
+
+// filesToCheck возвращает файлы пакета без исключенных через ExcludePaths
+// и, если не включен LintGenerated, без сгенерированного кода.
+func (r *runner) filesToCheck(pass *analysis.Pass) []*ast.File {
+	files := make([]*ast.File, 0, len(pass.Files))
+	for _, file := range pass.Files {
+		if !r.lintGenerated && ast.IsGenerated(file) {
+			continue
+		}
+
+		if r.excluded(pass.Fset.File(file.Pos()).Name()) {
+			continue
+		}
+
+		files = append(files, file)
+	}
+
+	return files
+}
+
+func (r *runner) excluded(filename string) bool {
+	if len(r.excludePaths) == 0 {
+		return false
+	}
+
+	rel, ok := relativePath(r.root, filename)
+	if !ok {
+		return false
+	}
+
+	for _, pattern := range r.excludePaths {
+		if matchGlob(pattern, rel) {
+			return true
+		}
+	}
+
+	return false
+}
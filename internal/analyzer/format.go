@@ -0,0 +1,134 @@
+package analyzer
+
+import (
+	"strings"
+	"unicode"
+)
+
+// formatSegment — кусок printf-формата: обычный текст или глагол вида %s / %-8.2f / %[1]v.
+type formatSegment struct {
+	text string
+	verb bool
+}
+
+// splitFormat разбивает printf-формат на текст и глаголы.
+// Последовательность %% считается текстом и превращается в один символ %.
+func splitFormat(format string) []formatSegment {
+	var (
+		segments []formatSegment
+		text     strings.Builder
+	)
+
+	flushText := func() {
+		if text.Len() == 0 {
+			return
+		}
+		segments = append(segments, formatSegment{text: text.String()})
+		text.Reset()
+	}
+
+	for i := 0; i < len(format); {
+		if format[i] != '%' {
+			text.WriteByte(format[i])
+			i++
+			continue
+		}
+
+		if i+1 < len(format) && format[i+1] == '%' {
+			text.WriteByte('%')
+			i += 2
+			continue
+		}
+
+		end := scanVerb(format, i+1)
+		flushText()
+		segments = append(segments, formatSegment{text: format[i:end], verb: true})
+		i = end
+	}
+	flushText()
+
+	return segments
+}
+
+// scanVerb возвращает позицию сразу после глагола, который начинается
+// с флагов, ширины, точности и индекса аргумента по смещению start.
+func scanVerb(format string, start int) int {
+	i := start
+	for i < len(format) {
+		c := format[i]
+		switch {
+		case strings.IndexByte("+-# 0.*", c) >= 0:
+			i++
+		case c >= '0' && c <= '9':
+			i++
+		case c == '[':
+			closing := strings.IndexByte(format[i:], ']')
+			if closing < 0 {
+				return len(format)
+			}
+			i += closing + 1
+		default:
+			// Сам глагол — одна руна (обычно ASCII-буква).
+			for j := range format[i:] {
+				if j > 0 {
+					return i + j
+				}
+			}
+			return len(format)
+		}
+	}
+
+	return i
+}
+
+// stripFormatVerbs возвращает текст формата без глаголов.
+func stripFormatVerbs(format string) string {
+	var b strings.Builder
+	b.Grow(len(format))
+	for _, segment := range splitFormat(format) {
+		if !segment.verb {
+			b.WriteString(segment.text)
+		}
+	}
+
+	return b.String()
+}
+
+func stripFormatVerbsAll(parts []string) []string {
+	if len(parts) == 0 {
+		return nil
+	}
+
+	result := make([]string, 0, len(parts))
+	for _, part := range parts {
+		result = append(result, stripFormatVerbs(part))
+	}
+
+	return result
+}
+
+// filterFormatToAlphaNumSpace — аналог filterToAlphaNumSpace для printf-формата:
+// глаголы сохраняются как есть, чтобы не сломать подстановку аргументов.
+func filterFormatToAlphaNumSpace(format string) string {
+	var b strings.Builder
+	b.Grow(len(format))
+	for _, segment := range splitFormat(format) {
+		if segment.verb {
+			b.WriteString(segment.text)
+			continue
+		}
+
+		for _, r := range segment.text {
+			switch {
+			case unicode.IsLetter(r):
+				b.WriteRune(r)
+			case unicode.IsDigit(r):
+				b.WriteRune(r)
+			case unicode.IsSpace(r):
+				b.WriteRune(' ')
+			}
+		}
+	}
+
+	return strings.Join(strings.Fields(b.String()), " ")
+}
@@ -0,0 +1,232 @@
+package analyzer
+
+import (
+	"go/ast"
+	"go/types"
+	"reflect"
+	"strings"
+
+	"github.com/victornechaev/loglint/internal/baseline"
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/types/typeutil"
+)
+
+// LogCall — вызов логгера в каталоге сообщений, которые может записать сервис.
+type LogCall struct {
+	// File — абсолютный путь файла.
+	File   string `json:"file"`
+	Line   int    `json:"line"`
+	Column int    `json:"column"`
+	// Func — объемлющая функция ("Func" или "Type.Method"); пусто на уровне пакета.
+	Func string `json:"func,omitempty"`
+	// Library — slog, zap, logrus, zerolog или путь пакета пользовательского логгера и обертки.
+	Library string `json:"library"`
+	// Level — уровень записи (debug, info, warn, error, ...); пусто, если его не определить.
+	Level string `json:"level,omitempty"`
+	// Message — текст сообщения, если он константный; для printf-методов — формат с глаголами.
+	Message string `json:"message,omitempty"`
+	// Parts — литеральные части динамического сообщения.
+	Parts []string `json:"parts,omitempty"`
+	// Keys — константные ключи атрибутов вызова.
+	Keys []string `json:"keys,omitempty"`
+}
+
+// NewInventory создает анализатор, который ничего не сообщает, а возвращает
+// результатом []LogCall — все вызовы логгеров пакета. Логгеры, обертки и исключенные
+// файлы определяются так же, как в New.
+func NewInventory(options Options) (*analysis.Analyzer, error) {
+	r, err := newRunner(options)
+	if err != nil {
+		return nil, err
+	}
+
+	return &analysis.Analyzer{
+		Name:       "loglintinventory",
+		Doc:        "collects log calls of slog, zap, logrus, zerolog and configured loggers",
+		Run:        r.inventory,
+		ResultType: reflect.TypeOf([]LogCall(nil)),
+		FactTypes: []analysis.Fact{
+			new(loggerWrapperFact),
+		},
+	}, nil
+}
+
+// libraries — короткие имена встроенных логгеров для LogCall.Library.
+var libraries = map[string]string{
+	"log/slog":                   "slog",
+	"go.uber.org/zap":            "zap",
+	"github.com/sirupsen/logrus": "logrus",
+	zerologPkgPath:               "zerolog",
+}
+
+// levelNames сопоставляет имени уровневого метода уровень записи.
+var levelNames = map[string]string{
+	"Trace":   "trace",
+	"Debug":   "debug",
+	"Info":    "info",
+	"Print":   "info",
+	"Warn":    "warn",
+	"Warning": "warn",
+	"Error":   "error",
+	"Err":     "error",
+	"DPanic":  "dpanic",
+	"Panic":   "panic",
+	"Fatal":   "fatal",
+}
+
+func (r *runner) inventory(pass *analysis.Pass) (any, error) {
+	r.detectWrappers(pass)
+
+	var calls []LogCall
+	for _, file := range r.filesToCheck(pass) {
+		ast.Inspect(file, func(node ast.Node) bool {
+			call, ok := node.(*ast.CallExpr)
+			if !ok {
+				return true
+			}
+
+			msg, ok := r.extractMessageExpr(pass, call)
+			if !ok {
+				return true
+			}
+
+			fn, ok := typeutil.Callee(pass.TypesInfo, call).(*types.Func)
+			if !ok {
+				return true
+			}
+
+			position := pass.Fset.Position(call.Pos())
+			logCall := LogCall{
+				File:    position.Filename,
+				Line:    position.Line,
+				Column:  position.Column,
+				Func:    baseline.EnclosingFunc(file, call.Pos()),
+				Library: fn.Pkg().Path(),
+				Level:   callLevel(pass, call, fn),
+				Keys:    r.attrKeys(pass, call),
+			}
+			if library, ok := libraries[logCall.Library]; ok {
+				logCall.Library = library
+			}
+
+			data := collectMessageData(pass, msg)
+			if data.hasFullText {
+				logCall.Message = data.sourceText()
+			} else {
+				logCall.Parts = data.literalParts
+			}
+
+			calls = append(calls, logCall)
+			return true
+		})
+	}
+
+	return calls, nil
+}
+
+// callLevel определяет уровень записи по имени метода (Infof, WarnContext, Errorw),
+// по константе уровня в logrus.Log(logrus.WarnLevel, ...) или по началу цепочки
+// zerolog: log.Error().Str(...).Msg(...).
+func callLevel(pass *analysis.Pass, call *ast.CallExpr, fn *types.Func) string {
+	if sel, ok := call.Fun.(*ast.SelectorExpr); ok && isZerologEvent(pass.TypesInfo.TypeOf(sel.X)) {
+		return zerologLevel(pass, sel.X)
+	}
+
+	if strings.HasPrefix(fn.Name(), "Log") && len(call.Args) > 0 {
+		if level := constantLevel(pass, call.Args[0]); level != "" {
+			return level
+		}
+	}
+
+	return methodLevel(fn.Name())
+}
+
+func methodLevel(name string) string {
+	name = strings.TrimSuffix(name, "Context")
+	for _, suffix := range []string{"", "f", "ln", "w"} {
+		if level, ok := levelNames[strings.TrimSuffix(name, suffix)]; ok {
+			return level
+		}
+	}
+
+	return ""
+}
+
+// constantLevel возвращает уровень по имени константы: logrus.WarnLevel, slog.LevelWarn.
+func constantLevel(pass *analysis.Pass, expr ast.Expr) string {
+	var ident *ast.Ident
+	switch e := ast.Unparen(expr).(type) {
+	case *ast.Ident:
+		ident = e
+	case *ast.SelectorExpr:
+		ident = e.Sel
+	default:
+		return ""
+	}
+
+	if _, ok := pass.TypesInfo.Uses[ident].(*types.Const); !ok {
+		return ""
+	}
+
+	name := strings.TrimPrefix(strings.TrimSuffix(ident.Name, "Level"), "Level")
+	return levelNames[name]
+}
+
+// zerologLevel идет по цепочке *zerolog.Event к вызову, который ее начал.
+func zerologLevel(pass *analysis.Pass, expr ast.Expr) string {
+	for {
+		call, ok := ast.Unparen(expr).(*ast.CallExpr)
+		if !ok {
+			return ""
+		}
+
+		sel, ok := call.Fun.(*ast.SelectorExpr)
+		if !ok {
+			return ""
+		}
+
+		if !isZerologEvent(pass.TypesInfo.TypeOf(sel.X)) {
+			if sel.Sel.Name == "WithLevel" && len(call.Args) > 0 {
+				return constantLevel(pass, call.Args[0])
+			}
+			return methodLevel(sel.Sel.Name)
+		}
+		expr = sel.X
+	}
+}
+
+// attrKeys возвращает константные ключи атрибутов вызова: пар ключ/значение,
+// готовых атрибутов (slog.String, zap.Int) и полей цепочки zerolog.
+func (r *runner) attrKeys(pass *analysis.Pass, call *ast.CallExpr) []string {
+	// Поля zerolog собраны от конца цепочки к началу.
+	attrs := zerologEventFields(pass, call)
+	for i, j := 0, len(attrs)-1; i < j; i, j = i+1, j-1 {
+		attrs[i], attrs[j] = attrs[j], attrs[i]
+	}
+
+	if tail, ok := r.extractKeyValueArgs(pass, call); ok {
+		attrs = append(attrs, keyValuePairs(pass, tail)...)
+	} else {
+		for _, arg := range call.Args {
+			argCall, ok := ast.Unparen(arg).(*ast.CallExpr)
+			if !ok || !isAttrType(pass.TypesInfo.TypeOf(arg)) {
+				continue
+			}
+			if attr, ok := attrConstructor(pass, argCall); ok {
+				attrs = append(attrs, attr)
+			}
+		}
+	}
+
+	var keys []string
+	for _, attr := range attrs {
+		if attr.key == nil {
+			continue
+		}
+		if key, ok := constantString(pass, attr.key); ok {
+			keys = append(keys, key)
+		}
+	}
+
+	return keys
+}
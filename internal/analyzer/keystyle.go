@@ -0,0 +1,118 @@
+package analyzer
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"golang.org/x/tools/go/analysis"
+)
+
+// Стили именования ключей атрибутов для Options.KeyStyle.
+const (
+	KeyStyleSnake  = "snake_case"
+	KeyStyleCamel  = "camelCase"
+	KeyStyleKebab  = "kebab-case"
+	KeyStyleDotted = "dotted"
+)
+
+// keyStyles сопоставляет стилю функцию, приводящую к нему ключ.
+var keyStyles = map[string]func(words []string) string{
+	KeyStyleSnake:  func(words []string) string { return strings.Join(words, "_") },
+	KeyStyleKebab:  func(words []string) string { return strings.Join(words, "-") },
+	KeyStyleDotted: func(words []string) string { return strings.Join(words, ".") },
+	KeyStyleCamel:  joinCamel,
+}
+
+func (r *runner) checkKeyStyle(pass *analysis.Pass, attrs []logAttr) {
+	if r.keyStyle == "" {
+		return
+	}
+
+	convert := keyStyles[r.keyStyle]
+	for _, attr := range attrs {
+		key, ok := constantString(pass, attr.key)
+		if !ok || key == "" {
+			continue
+		}
+
+		fixed := convert(splitKeyWords(key))
+		if fixed == "" || fixed == key {
+			continue
+		}
+
+		diag := analysis.Diagnostic{
+			Pos:     attr.key.Pos(),
+			End:     attr.key.End(),
+			Message: fmt.Sprintf("log attribute key %q should be %s", key, r.keyStyle),
+		}
+
+		// Переписать можно только ключ, записанный литералом прямо в вызове.
+		if lit, ok := ast.Unparen(attr.key).(*ast.BasicLit); ok && lit.Kind == token.STRING && !r.disableFixes {
+			diag.SuggestedFixes = []analysis.SuggestedFix{{
+				Message: fmt.Sprintf("rename key to %q", fixed),
+				TextEdits: []analysis.TextEdit{{
+					Pos:     lit.Pos(),
+					End:     lit.End(),
+					NewText: []byte(strconv.Quote(fixed)),
+				}},
+			}}
+		}
+
+		r.report(pass, ruleKeyStyle, diag)
+	}
+}
+
+// splitKeyWords разбивает ключ на слова в нижнем регистре. Границами слов считаются
+// разделители (_ - . пробел) и смена регистра: "userID" -> [user id], "HTTPStatus" -> [http status].
+func splitKeyWords(key string) []string {
+	var (
+		words   []string
+		current []rune
+	)
+
+	flush := func() {
+		if len(current) > 0 {
+			words = append(words, strings.ToLower(string(current)))
+			current = current[:0]
+		}
+	}
+
+	runes := []rune(key)
+	for i, r := range runes {
+		switch {
+		case r == '_' || r == '-' || r == '.' || unicode.IsSpace(r):
+			flush()
+			continue
+		case unicode.IsUpper(r) && i > 0:
+			prev := runes[i-1]
+			nextLower := i+1 < len(runes) && unicode.IsLower(runes[i+1])
+			if unicode.IsLower(prev) || unicode.IsDigit(prev) || unicode.IsUpper(prev) && nextLower {
+				flush()
+			}
+		}
+		current = append(current, r)
+	}
+	flush()
+
+	return words
+}
+
+func joinCamel(words []string) string {
+	var b strings.Builder
+	for i, word := range words {
+		if i == 0 {
+			b.WriteString(word)
+			continue
+		}
+
+		runes := []rune(word)
+		runes[0] = unicode.ToUpper(runes[0])
+		b.WriteString(string(runes))
+	}
+
+	return b.String()
+}
@@ -2,91 +2,183 @@ package analyzer
 
 import (
 	"go/ast"
+	"go/token"
 	"go/types"
 
 	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/types/typeutil"
 )
 
-func extractMessageExpr(pass *analysis.Pass, call *ast.CallExpr) (ast.Expr, bool) {
-	sel, ok := call.Fun.(*ast.SelectorExpr)
-	if !ok {
-		return nil, false
+// messageKind описывает, как логгер собирает текст сообщения из аргументов.
+type messageKind int
+
+const (
+	// messagePlain — сообщение передается одним аргументом (msg string)
+	// или склеивается из variadic-хвоста через fmt.Sprint (args ...any).
+	messagePlain messageKind = iota
+	// messageLine — variadic-хвост склеивается через fmt.Sprintln.
+	messageLine
+	// messagePrintf — первый аргумент сообщения является форматом fmt.Sprintf,
+	// остальные аргументы подставляются в него.
+	messagePrintf
+	// messageKeyValue — сообщение передается одним аргументом,
+	// за которым следуют пары ключ/значение.
+	messageKeyValue
+)
+
+// logMessage — аргументы вызова логгера, из которых складывается текст сообщения.
+type logMessage struct {
+	kind messageKind
+	args []ast.Expr
+	// sprint — вызов fmt.Sprintf/Sprint/Sprintln, переданный логгеру как сообщение.
+	// В этом случае args содержит аргументы этого вызова.
+	sprint *ast.CallExpr
+}
+
+func (m logMessage) Pos() token.Pos {
+	if m.sprint != nil {
+		return m.sprint.Pos()
 	}
 
-	msgIndex, ok := resolveMessageIndex(pass, sel)
-	if !ok || msgIndex < 0 || len(call.Args) <= msgIndex {
-		return nil, false
+	return m.args[0].Pos()
+}
+
+func (m logMessage) End() token.Pos {
+	if m.sprint != nil {
+		return m.sprint.End()
 	}
 
-	return call.Args[msgIndex], true
+	return m.args[len(m.args)-1].End()
 }
 
-func resolveMessageIndex(pass *analysis.Pass, sel *ast.SelectorExpr) (int, bool) {
-	// Вызовы пакетного уровня (например, slog.Info / slog.InfoContext).
-	if pkgPath, ok := packagePath(pass, sel.X); ok {
-		switch pkgPath {
-		case "log/slog":
-			switch sel.Sel.Name {
-			case "Debug", "Info", "Warn", "Error":
-				return 0, true
-			case "DebugContext", "InfoContext", "WarnContext", "ErrorContext":
-				return 1, true
-			}
-		}
+// textNode возвращает узел, который содержит текст сообщения в исходном коде:
+// для printf-сообщений это только формат, для остальных — все аргументы сообщения.
+func (m logMessage) textNode() ast.Node {
+	if m.kind == messagePrintf {
+		return m.args[0]
 	}
 
-	// Вызовы методов на инстансах логгеров (например, logger.Info / sugar.Infow).
-	named := namedType(pass.TypesInfo.TypeOf(sel.X))
-	if named == nil || named.Obj() == nil || named.Obj().Pkg() == nil {
-		return -1, false
+	return m
+}
+
+func (r *runner) extractMessageExpr(pass *analysis.Pass, call *ast.CallExpr) (logMessage, bool) {
+	msgIndex, kind, ok := r.resolveMessageIndex(pass, call)
+	if !ok || msgIndex < 0 || len(call.Args) <= msgIndex {
+		return logMessage{}, false
 	}
 
-	pkgPath := named.Obj().Pkg().Path()
-	typeName := named.Obj().Name()
-	methodName := sel.Sel.Name
+	// Для printf-методов и variadic-методов вида Info(args ...any)
+	// сообщение складывается из всего хвоста аргументов.
+	args := call.Args[msgIndex : msgIndex+1]
+	if kind == messagePrintf || kind != messageKeyValue && isVariadicParam(pass, call, msgIndex) {
+		args = call.Args[msgIndex:]
+	}
 
-	switch {
-	case pkgPath == "log/slog" && typeName == "Logger":
-		switch methodName {
-		case "Debug", "Info", "Warn", "Error":
-			return 0, true
-		case "DebugContext", "InfoContext", "WarnContext", "ErrorContext":
-			return 1, true
-		}
-	case pkgPath == "go.uber.org/zap" && typeName == "Logger":
-		switch methodName {
-		case "Debug", "Info", "Warn", "Error", "DPanic", "Panic", "Fatal":
-			return 0, true
-		}
-	case pkgPath == "go.uber.org/zap" && typeName == "SugaredLogger":
-		switch methodName {
-		case "Debug", "Info", "Warn", "Error", "DPanic", "Panic", "Fatal",
-			"Debugw", "Infow", "Warnw", "Errorw", "DPanicw", "Panicw", "Fatalw":
-			return 0, true
+	msg := logMessage{kind: kind, args: args}
+	if len(args) == 1 && kind != messagePrintf {
+		if unwrapped, ok := unwrapSprint(pass, args[0]); ok {
+			msg = unwrapped
 		}
 	}
 
-	return -1, false
+	return msg, true
+}
+
+// withMethods — методы, которые создают дочерний логгер с парами ключ/значение.
+var withMethods = map[loggerKey]struct{}{
+	{pkg: "log/slog", typ: "Logger", method: "With"}:               {},
+	{pkg: "go.uber.org/zap", typ: "SugaredLogger", method: "With"}: {},
+}
+
+// extractKeyValueArgs возвращает хвост пар ключ/значение вызова: аргументы после
+// сообщения у key-value методов логгеров или все аргументы With.
+// Хвост, переданный как args..., статически не разобрать.
+func (r *runner) extractKeyValueArgs(pass *analysis.Pass, call *ast.CallExpr) ([]ast.Expr, bool) {
+	if call.Ellipsis.IsValid() {
+		return nil, false
+	}
+
+	if isWithCall(pass, call) {
+		return call.Args, true
+	}
+
+	msgIndex, kind, ok := r.resolveMessageIndex(pass, call)
+	if !ok || kind != messageKeyValue || msgIndex >= len(call.Args) {
+		return nil, false
+	}
+
+	return call.Args[msgIndex+1:], true
 }
 
-func packagePath(pass *analysis.Pass, expr ast.Expr) (string, bool) {
-	// Для селекторов пакетного уровня путь импорта получаем через PkgName.
-	ident, ok := expr.(*ast.Ident)
+func isWithCall(pass *analysis.Pass, call *ast.CallExpr) bool {
+	fn, ok := typeutil.Callee(pass.TypesInfo, call).(*types.Func)
 	if !ok {
-		return "", false
+		return false
 	}
 
-	usedObj, ok := pass.TypesInfo.Uses[ident]
+	key, ok := calleeKey(fn)
 	if !ok {
-		return "", false
+		return false
 	}
 
-	pkgName, ok := usedObj.(*types.PkgName)
-	if !ok || pkgName.Imported() == nil {
-		return "", false
+	_, ok = withMethods[key]
+	return ok
+}
+
+// unwrapSprint раскрывает сообщения вида fmt.Sprintf("user %s", name):
+// дальше они проверяются так же, как аргументы printf-методов логгеров.
+func unwrapSprint(pass *analysis.Pass, expr ast.Expr) (logMessage, bool) {
+	call, ok := ast.Unparen(expr).(*ast.CallExpr)
+	if !ok || len(call.Args) == 0 || call.Ellipsis.IsValid() {
+		return logMessage{}, false
+	}
+
+	fn, ok := typeutil.Callee(pass.TypesInfo, call).(*types.Func)
+	if !ok || fn.Pkg() == nil || fn.Pkg().Path() != "fmt" {
+		return logMessage{}, false
+	}
+
+	var kind messageKind
+	switch fn.Name() {
+	case "Sprintf":
+		kind = messagePrintf
+	case "Sprint":
+		kind = messagePlain
+	case "Sprintln":
+		kind = messageLine
+	default:
+		return logMessage{}, false
+	}
+
+	return logMessage{kind: kind, args: call.Args, sprint: call}, true
+}
+
+// resolveMessageIndex ищет вызываемую функцию в реестре логгеров, а затем среди
+// оберток над логгерами, и возвращает индекс аргумента с сообщением и способ его сборки.
+func (r *runner) resolveMessageIndex(pass *analysis.Pass, call *ast.CallExpr) (int, messageKind, bool) {
+	fn, ok := typeutil.Callee(pass.TypesInfo, call).(*types.Func)
+	if !ok {
+		return -1, messagePlain, false
+	}
+
+	if key, ok := calleeKey(fn); ok {
+		if method, ok := r.loggers[key]; ok {
+			return method.msgIndex, method.kind, true
+		}
+	}
+
+	return r.wrapperMessageIndex(pass, fn)
+}
+
+// isVariadicParam сообщает, попадает ли аргумент с индексом index
+// в variadic-параметр вызываемой функции.
+func isVariadicParam(pass *analysis.Pass, call *ast.CallExpr, index int) bool {
+	sig, ok := pass.TypesInfo.TypeOf(call.Fun).(*types.Signature)
+	if !ok || !sig.Variadic() {
+		return false
 	}
 
-	return pkgName.Imported().Path(), true
+	return index >= sig.Params().Len()-1
 }
 
 func namedType(typ types.Type) *types.Named {
@@ -15,27 +15,43 @@ type messageData struct {
 	hasFullText  bool
 	literalParts []string
 	hasDynamic   bool
+	// format — исходный текст printf-формата вместе с глаголами.
+	// Заполняется только для printf-сообщений с константным форматом.
+	format string
 }
 
-func collectMessageData(pass *analysis.Pass, expr ast.Expr) messageData {
-	// Сначала пробуем вычислить значение как константу времени компиляции.
-	// Это покрывает обычные литералы и полностью вычислимые выражения.
-	tv, ok := pass.TypesInfo.Types[expr]
-	if ok && tv.Value != nil && tv.Value.Kind() == constant.String {
-		value := constant.StringVal(tv.Value)
-		return messageData{
-			fullText:     value,
-			hasFullText:  true,
-			literalParts: []string{value},
-			hasDynamic:   false,
-		}
+// sourceText возвращает текст сообщения в том виде, в котором он записан в коде.
+// Именно его переписывают автоисправления.
+func (d messageData) sourceText() string {
+	if d.format != "" {
+		return d.format
+	}
+
+	return d.fullText
+}
+
+func collectMessageData(pass *analysis.Pass, msg logMessage) messageData {
+	if msg.kind == messagePrintf {
+		return collectFormatData(pass, msg.args[0], len(msg.args) > 1)
+	}
+
+	separator := ""
+	if msg.kind == messageLine {
+		separator = " "
+	}
+
+	var (
+		parts   []string
+		dynamic bool
+	)
+	for _, arg := range msg.args {
+		argParts, argDynamic := collectExprParts(pass, arg)
+		parts = append(parts, argParts...)
+		dynamic = dynamic || argDynamic
 	}
 
-	// Если полностью вычислить выражение нельзя, сохраняем литеральные части.
-	// Они используются как контекст для sensitive-проверок динамических выражений.
-	parts, dynamic := collectLiteralParts(expr)
 	if !dynamic && len(parts) > 0 {
-		joined := strings.Join(parts, "")
+		joined := strings.Join(parts, separator)
 		return messageData{
 			fullText:     joined,
 			hasFullText:  true,
@@ -50,6 +66,50 @@ func collectMessageData(pass *analysis.Pass, expr ast.Expr) messageData {
 	}
 }
 
+// collectFormatData собирает данные printf-сообщения: текстом для правил стиля
+// считается формат без глаголов, а подставляемые аргументы делают сообщение динамическим.
+func collectFormatData(pass *analysis.Pass, formatExpr ast.Expr, hasArgs bool) messageData {
+	parts, dynamic := collectExprParts(pass, formatExpr)
+	if dynamic || len(parts) == 0 {
+		return messageData{
+			literalParts: stripFormatVerbsAll(parts),
+			hasDynamic:   true,
+		}
+	}
+
+	format := strings.Join(parts, "")
+	text := stripFormatVerbs(format)
+	return messageData{
+		fullText:     text,
+		hasFullText:  true,
+		literalParts: []string{text},
+		hasDynamic:   hasArgs,
+		format:       format,
+	}
+}
+
+func collectExprParts(pass *analysis.Pass, expr ast.Expr) ([]string, bool) {
+	// Сначала пробуем вычислить значение как константу времени компиляции.
+	// Это покрывает обычные литералы и полностью вычислимые выражения.
+	if value, ok := constantString(pass, expr); ok {
+		return []string{value}, false
+	}
+
+	// Если полностью вычислить выражение нельзя, сохраняем литеральные части.
+	// Они используются как контекст для sensitive-проверок динамических выражений.
+	return collectLiteralParts(expr)
+}
+
+// constantString возвращает значение выражения, если это строковая константа.
+func constantString(pass *analysis.Pass, expr ast.Expr) (string, bool) {
+	tv, ok := pass.TypesInfo.Types[expr]
+	if !ok || tv.Value == nil || tv.Value.Kind() != constant.String {
+		return "", false
+	}
+
+	return constant.StringVal(tv.Value), true
+}
+
 func collectLiteralParts(expr ast.Expr) ([]string, bool) {
 	switch e := expr.(type) {
 	case *ast.BasicLit:
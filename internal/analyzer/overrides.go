@@ -0,0 +1,204 @@
+package analyzer
+
+import (
+	"fmt"
+	"go/ast"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"golang.org/x/tools/go/analysis"
+)
+
+// Override меняет настройки правил для части кода: файлов, подходящих под Paths,
+// или пакетов, подходящих под Packages. Несколько подходящих переопределений
+// применяются по порядку.
+type Override struct {
+	// Root — каталог, относительно которого заданы Paths; пустой — текущий каталог.
+	Root string
+	// Paths — glob-шаблоны путей файлов через "/": "cmd/**", "internal/*/handler.go".
+	// Шаблон без "/" сравнивается с именем файла: "*_test.go".
+	Paths []string
+	// Packages — пути импорта пакетов; суффикс "/..." включает вложенные пакеты.
+	Packages []string
+	// DisabledRules дополняет Options.DisabledRules.
+	DisabledRules []string
+	// SensitivePatterns дополняет Options.SensitivePatterns.
+	SensitivePatterns []string
+	// Severity переопределяет уровни важности из Options.Severity.
+	Severity map[string]string
+}
+
+type override struct {
+	root              string
+	paths             []string
+	packages          []string
+	disabledRules     map[string]struct{}
+	sensitivePatterns []string
+	severities        map[string]string
+}
+
+func compileOverrides(overrides []Override) ([]override, error) {
+	result := make([]override, 0, len(overrides))
+	for i, o := range overrides {
+		if len(o.Paths) == 0 && len(o.Packages) == 0 {
+			return nil, fmt.Errorf("override %d: paths or packages are required", i)
+		}
+
+		if err := validateGlobs(o.Paths); err != nil {
+			return nil, fmt.Errorf("override %d: %w", i, err)
+		}
+
+		root, err := absRoot(o.Root)
+		if err != nil {
+			return nil, fmt.Errorf("override %d: %w", i, err)
+		}
+
+		severities, err := normalizeSeverities(o.Severity)
+		if err != nil {
+			return nil, fmt.Errorf("override %d: %w", i, err)
+		}
+
+		result = append(result, override{
+			root:              root,
+			paths:             o.Paths,
+			packages:          o.Packages,
+			disabledRules:     normalizeDisabledRules(o.DisabledRules),
+			sensitivePatterns: o.SensitivePatterns,
+			severities:        severities,
+		})
+	}
+
+	return result, nil
+}
+
+func (o *override) matches(filename, pkgPath string) bool {
+	for _, pattern := range o.packages {
+		if matchPackage(pattern, pkgPath) {
+			return true
+		}
+	}
+
+	rel, ok := relativePath(o.root, filename)
+	if !ok {
+		return false
+	}
+
+	for _, pattern := range o.paths {
+		if matchGlob(pattern, rel) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// relativePath возвращает путь файла относительно root через "/".
+// Для файлов вне root возвращается false.
+func relativePath(root, filename string) (string, bool) {
+	rel, err := filepath.Rel(root, filename)
+	if err != nil || rel == ".." || strings.HasPrefix(rel, ".."+string(os.PathSeparator)) {
+		return "", false
+	}
+
+	return filepath.ToSlash(rel), true
+}
+
+// forFile возвращает настройки правил для файла с учетом подходящих переопределений.
+// Если переопределений нет, возвращается сам r.
+func (r *runner) forFile(pass *analysis.Pass, file *ast.File) *runner {
+	if len(r.overrides) == 0 {
+		return r
+	}
+
+	filename := pass.Fset.File(file.Pos()).Name()
+	result := r
+	for i := range r.overrides {
+		o := &r.overrides[i]
+		if !o.matches(filename, pass.Pkg.Path()) {
+			continue
+		}
+
+		if result == r {
+			copied := *r
+			result = &copied
+		}
+		result.apply(o)
+	}
+
+	return result
+}
+
+func (r *runner) apply(o *override) {
+	if len(o.disabledRules) > 0 {
+		disabled := make(map[string]struct{}, len(r.disabledRules)+len(o.disabledRules))
+		for rule := range r.disabledRules {
+			disabled[rule] = struct{}{}
+		}
+		for rule := range o.disabledRules {
+			disabled[rule] = struct{}{}
+		}
+		r.disabledRules = disabled
+	}
+
+	if len(o.severities) > 0 {
+		severities := make(map[string]string, len(r.severities)+len(o.severities))
+		for rule, level := range r.severities {
+			severities[rule] = level
+		}
+		for rule, level := range o.severities {
+			severities[rule] = level
+		}
+		r.severities = severities
+	}
+
+	if len(o.sensitivePatterns) > 0 {
+		r.sensitivePatterns = addSensitivePatterns(r.sensitivePatterns, o.sensitivePatterns, false)
+	}
+}
+
+// matchPackage сравнивает путь пакета с шаблоном в стиле go list: "example.com/app/cmd/..."
+// подходит для самого пакета cmd и всех вложенных.
+func matchPackage(pattern, pkgPath string) bool {
+	if prefix, ok := strings.CutSuffix(pattern, "/..."); ok {
+		return pkgPath == prefix || strings.HasPrefix(pkgPath, prefix+"/")
+	}
+
+	return pattern == pkgPath
+}
+
+// matchGlob сравнивает путь через "/" с glob-шаблоном, где "**" соответствует
+// любому числу каталогов. Шаблон без "/" сравнивается с именем файла.
+func matchGlob(pattern, name string) bool {
+	if !strings.Contains(pattern, "/") {
+		ok, _ := path.Match(pattern, path.Base(name))
+		return ok
+	}
+
+	return matchSegments(strings.Split(pattern, "/"), strings.Split(name, "/"))
+}
+
+func matchSegments(pattern, name []string) bool {
+	for len(pattern) > 0 {
+		if pattern[0] == "**" {
+			for i := len(name); i >= 0; i-- {
+				if matchSegments(pattern[1:], name[i:]) {
+					return true
+				}
+			}
+			return false
+		}
+
+		if len(name) == 0 {
+			return false
+		}
+
+		if ok, _ := path.Match(pattern[0], name[0]); !ok {
+			return false
+		}
+		pattern, name = pattern[1:], name[1:]
+	}
+
+	return len(name) == 0
+}
@@ -0,0 +1,126 @@
+package analyzer
+
+import (
+	"fmt"
+	"go/ast"
+	"go/types"
+
+	"golang.org/x/tools/go/analysis"
+)
+
+// reservedKeys — ключи встроенных полей записи; атрибут с таким ключом
+// затирает или дублирует их в выводе обработчика.
+var reservedKeys = map[string]struct{}{
+	"time":   {},
+	"level":  {},
+	"msg":    {},
+	"source": {},
+}
+
+// checkKeyValuePairs проверяет хвост пар ключ/значение вызова: ключ без значения,
+// ключ не строкового типа, повтор ключа в вызове или в цепочке With и
+// совпадение с зарезервированными ключами.
+func (r *runner) checkKeyValuePairs(pass *analysis.Pass, call *ast.CallExpr) {
+	tail, ok := r.extractKeyValueArgs(pass, call)
+	if !ok {
+		return
+	}
+
+	seen := r.withChainKeys(pass, call)
+	for _, attr := range keyValuePairs(pass, tail) {
+		if attr.key == nil {
+			continue
+		}
+
+		if !attr.ready && !r.checkPairKey(pass, attr) {
+			continue
+		}
+
+		key, ok := constantString(pass, attr.key)
+		if !ok {
+			continue
+		}
+
+		if _, reserved := reservedKeys[key]; reserved {
+			r.report(pass, ruleKeyValuePairs, analysis.Diagnostic{
+				Pos:     attr.key.Pos(),
+				End:     attr.key.End(),
+				Message: fmt.Sprintf("log attribute key %q collides with a reserved key", key),
+			})
+		}
+
+		if _, duplicate := seen[key]; duplicate {
+			r.report(pass, ruleKeyValuePairs, analysis.Diagnostic{
+				Pos:     attr.key.Pos(),
+				End:     attr.key.End(),
+				Message: fmt.Sprintf("duplicate log attribute key %q", key),
+			})
+		}
+		seen[key] = struct{}{}
+	}
+}
+
+// checkPairKey сообщает о ключе без значения и ключе не строкового типа.
+// Возвращает false, если ключ уже признан некорректным.
+func (r *runner) checkPairKey(pass *analysis.Pass, attr logAttr) bool {
+	if typ := pass.TypesInfo.TypeOf(attr.key); typ != nil && !types.IsInterface(typ) &&
+		!types.AssignableTo(typ, types.Typ[types.String]) {
+		r.report(pass, ruleKeyValuePairs, analysis.Diagnostic{
+			Pos:     attr.key.Pos(),
+			End:     attr.key.End(),
+			Message: fmt.Sprintf("log attribute key should be a string, got %s", typ),
+		})
+		return false
+	}
+
+	if attr.value == nil {
+		r.report(pass, ruleKeyValuePairs, analysis.Diagnostic{
+			Pos:     attr.key.Pos(),
+			End:     attr.key.End(),
+			Message: "log attribute key has no value",
+		})
+		return false
+	}
+
+	return true
+}
+
+// withChainKeys собирает константные ключи, добавленные к логгеру вызовами With
+// в цепочке получателя, например logger.With("a", 1).Info("msg", "a", 2).
+func (r *runner) withChainKeys(pass *analysis.Pass, call *ast.CallExpr) map[string]struct{} {
+	keys := make(map[string]struct{})
+
+	sel, ok := ast.Unparen(call.Fun).(*ast.SelectorExpr)
+	if !ok {
+		return keys
+	}
+
+	recv := sel.X
+	for {
+		recvCall, ok := ast.Unparen(recv).(*ast.CallExpr)
+		if !ok {
+			break
+		}
+
+		recvSel, ok := ast.Unparen(recvCall.Fun).(*ast.SelectorExpr)
+		if !ok {
+			break
+		}
+
+		// Другие вызовы (например, WithGroup) меняют пространство ключей — дальше не идем.
+		if !isWithCall(pass, recvCall) {
+			break
+		}
+
+		if !recvCall.Ellipsis.IsValid() {
+			for _, attr := range keyValuePairs(pass, recvCall.Args) {
+				if key, ok := constantString(pass, attr.key); ok {
+					keys[key] = struct{}{}
+				}
+			}
+		}
+		recv = recvSel.X
+	}
+
+	return keys
+}
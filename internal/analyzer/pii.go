@@ -0,0 +1,285 @@
+package analyzer
+
+import (
+	"fmt"
+	"math/big"
+	"net/netip"
+	"regexp"
+	"strings"
+)
+
+// Имена встроенных детекторов персональных данных для Options.PIIDetectors.
+const (
+	// PIICreditCard — номер банковской карты из 13–19 цифр, прошедший проверку Луна.
+	PIICreditCard = "credit-card"
+	// PIIIBAN — IBAN с верной контрольной суммой (mod 97).
+	PIIIBAN = "iban"
+	// PIIEmail — адрес электронной почты.
+	PIIEmail = "email"
+	// PIIPhone — телефон в формате E.164 (+14155552671) с выделенным кодом страны и
+	// допустимой для него длиной номера.
+	PIIPhone = "phone"
+	// PIIIPv4 — IPv4-адрес, кроме loopback и 0.0.0.0.
+	PIIIPv4 = "ipv4"
+	// PIIIPv6 — IPv6-адрес, кроме loopback и ::.
+	PIIIPv6 = "ipv6"
+	// PIIUSSSN — номер социального страхования США: 123-45-6789.
+	PIIUSSSN = "us-ssn"
+	// PIIRUINN — российский ИНН из 10 или 12 цифр с верными контрольными цифрами.
+	PIIRUINN = "ru-inn"
+	// PIIRUSNILS — российский СНИЛС 123-456-789 01 с верной контрольной суммой.
+	PIIRUSNILS = "ru-snils"
+)
+
+// piiDetector находит кандидатов регулярным выражением и отбрасывает тех,
+// кто не проходит проверку формата или контрольной суммы.
+type piiDetector struct {
+	name  string
+	re    *regexp.Regexp
+	valid func(string) bool
+	// numeric означает числовой номер: кандидат, который продолжает соседнюю группу цифр
+	// ("DE89 3704 0044 ..."), — часть более длинного номера, а не отдельное значение.
+	numeric bool
+}
+
+var piiDetectors = []piiDetector{
+	{name: PIICreditCard, re: regexp.MustCompile(`\b(?:\d[ -]?){12,18}\d\b`), valid: validCardNumber, numeric: true},
+	{name: PIIIBAN, re: regexp.MustCompile(`\b[A-Z]{2}\d{2}(?: ?[A-Z0-9]{4}){2,7}(?: ?[A-Z0-9]{1,3})?\b`), valid: validIBAN},
+	{name: PIIEmail, re: regexp.MustCompile(`\b[A-Za-z0-9._%+-]+@[A-Za-z0-9-]+(?:\.[A-Za-z0-9-]+)*\.[A-Za-z]{2,}\b`), valid: validEmail},
+	{name: PIIPhone, re: regexp.MustCompile(`\+[1-9]\d{7,14}\b`), valid: validPhone, numeric: true},
+	{name: PIIIPv4, re: regexp.MustCompile(`\b(?:\d{1,3}\.){3}\d{1,3}\b`), valid: validIP(true), numeric: true},
+	{name: PIIIPv6, re: regexp.MustCompile(`(?i)[0-9a-f]{0,4}(?::[0-9a-f]{0,4}){2,7}`), valid: validIP(false)},
+	{name: PIIUSSSN, re: regexp.MustCompile(`\b\d{3}-\d{2}-\d{4}\b`), valid: validSSN, numeric: true},
+	{name: PIIRUINN, re: regexp.MustCompile(`\b\d{10}(?:\d{2})?\b`), valid: validINN, numeric: true},
+	{name: PIIRUSNILS, re: regexp.MustCompile(`\b\d{3}-\d{3}-\d{3}[ -]\d{2}\b`), valid: validSNILS, numeric: true},
+}
+
+// compilePIIDetectors выбирает встроенные детекторы по именам.
+func compilePIIDetectors(names []string) ([]piiDetector, error) {
+	if len(names) == 0 {
+		return nil, nil
+	}
+
+	byName := make(map[string]piiDetector, len(piiDetectors))
+	for _, detector := range piiDetectors {
+		byName[detector.name] = detector
+	}
+
+	var (
+		result []piiDetector
+		seen   = make(map[string]bool)
+	)
+	for _, name := range names {
+		name = strings.ToLower(strings.TrimSpace(name))
+		detector, ok := byName[name]
+		if !ok {
+			return nil, fmt.Errorf("unknown pii detector %q", name)
+		}
+		if !seen[name] {
+			seen[name] = true
+			result = append(result, detector)
+		}
+	}
+
+	return result, nil
+}
+
+// findPII возвращает первого кандидата текста, прошедшего проверку одного из детекторов.
+func findPII(text string, detectors []piiDetector) (sensitiveMatch, bool) {
+	for _, detector := range detectors {
+		for _, loc := range detector.re.FindAllStringIndex(text, -1) {
+			if detector.numeric && continuesNumber(text, loc[0], loc[1]) {
+				continue
+			}
+
+			if candidate := text[loc[0]:loc[1]]; detector.valid(candidate) {
+				return sensitiveMatch{detector: "pii detector: " + detector.name, fragment: maskFragment(candidate)}, true
+			}
+		}
+	}
+
+	return sensitiveMatch{}, false
+}
+
+// continuesNumber сообщает, примыкает ли text[start:end] к цифре слева или справа
+// непосредственно или через один пробел, дефис или точку.
+func continuesNumber(text string, start, end int) bool {
+	isDigit := func(i int) bool { return i >= 0 && i < len(text) && text[i] >= '0' && text[i] <= '9' }
+	isSeparator := func(i int) bool { return i >= 0 && i < len(text) && strings.IndexByte(" -.", text[i]) >= 0 }
+
+	return isDigit(start-1) || isSeparator(start-1) && isDigit(start-2) ||
+		isDigit(end) || isSeparator(end) && isDigit(end+1)
+}
+
+// digitsOnly возвращает цифры строки, пропуская пробелы и дефисы.
+func digitsOnly(text string) string {
+	return strings.NewReplacer(" ", "", "-", "").Replace(text)
+}
+
+func validCardNumber(candidate string) bool {
+	digits := digitsOnly(candidate)
+	if len(digits) < 13 || len(digits) > 19 {
+		return false
+	}
+
+	sum := 0
+	for i := range digits {
+		d := int(digits[len(digits)-1-i] - '0')
+		if i%2 == 1 {
+			d *= 2
+			if d > 9 {
+				d -= 9
+			}
+		}
+		sum += d
+	}
+
+	return sum%10 == 0
+}
+
+// validIBAN проверяет длину и контрольную сумму ISO 13616: буквы заменяются числами
+// (A = 10, ..., Z = 35), первые четыре символа переносятся в конец, остаток от деления на 97 — 1.
+func validIBAN(candidate string) bool {
+	iban := strings.ReplaceAll(candidate, " ", "")
+	if len(iban) < 15 || len(iban) > 34 {
+		return false
+	}
+
+	var numeric strings.Builder
+	for _, r := range iban[4:] + iban[:4] {
+		switch {
+		case r >= '0' && r <= '9':
+			numeric.WriteRune(r)
+		case r >= 'A' && r <= 'Z':
+			fmt.Fprintf(&numeric, "%d", r-'A'+10)
+		default:
+			return false
+		}
+	}
+
+	n, ok := new(big.Int).SetString(numeric.String(), 10)
+	return ok && new(big.Int).Mod(n, big.NewInt(97)).Int64() == 1
+}
+
+func validEmail(candidate string) bool {
+	local, _, _ := strings.Cut(candidate, "@")
+	return !strings.HasPrefix(local, ".") && !strings.HasSuffix(local, ".") && !strings.Contains(candidate, "..")
+}
+
+// validIP разбирает адрес и пропускает loopback и неуказанный адрес: они не указывают на человека.
+func validIP(v4 bool) func(string) bool {
+	return func(candidate string) bool {
+		addr, err := netip.ParseAddr(candidate)
+		if err != nil || addr.Is4() != v4 || addr.Is4In6() {
+			return false
+		}
+
+		return !addr.IsLoopback() && !addr.IsUnspecified()
+	}
+}
+
+// phoneCountryCodes — коды стран E.164, выделенные МСЭ. Коды не являются префиксами друг
+// друга, поэтому код номера определяется однозначно.
+var phoneCountryCodes = func() map[string]struct{} {
+	codes := make(map[string]struct{})
+	for _, code := range strings.Fields(`
+		1 7
+		20 27 30 31 32 33 34 36 39 40 41 43 44 45 46 47 48 49 51 52 53 54 55 56 57 58
+		60 61 62 63 64 65 66 81 82 84 86 90 91 92 93 94 95 98
+		211 212 213 216 218 220 221 222 223 224 225 226 227 228 229
+		230 231 232 233 234 235 236 237 238 239 240 241 242 243 244 245 246 247 248 249
+		250 251 252 253 254 255 256 257 258 260 261 262 263 264 265 266 267 268 269
+		290 291 297 298 299 350 351 352 353 354 355 356 357 358 359
+		370 371 372 373 374 375 376 377 378 379 380 381 382 383 385 386 387 389 420 421 423
+		500 501 502 503 504 505 506 507 508 509 590 591 592 593 594 595 596 597 598 599
+		670 672 673 674 675 676 677 678 679 680 681 682 683 685 686 687 688 689 690 691 692
+		800 808 850 852 853 855 856 870 880 881 882 883 886 888
+		960 961 962 963 964 965 966 967 968 970 971 972 973 974 975 976 977 979
+		992 993 994 995 996 998`) {
+		codes[code] = struct{}{}
+	}
+
+	return codes
+}()
+
+// phoneNationalLengths — допустимая длина национального номера для кодов стран, где она
+// фиксирована; для остальных кодов проверяется только общий предел E.164 в 15 цифр.
+var phoneNationalLengths = map[string][2]int{
+	"1":  {10, 10},
+	"7":  {10, 10},
+	"33": {9, 9},
+	"34": {9, 9},
+	"44": {9, 10},
+	"61": {9, 9},
+	"81": {9, 10},
+	"91": {10, 10},
+}
+
+// validPhone выделяет код страны и проверяет длину национального номера. Для кода 1
+// (NANP) код региона и номер АТС не начинаются с 0 и 1.
+func validPhone(candidate string) bool {
+	digits := strings.TrimPrefix(candidate, "+")
+	for size := 1; size <= 3 && size < len(digits); size++ {
+		code := digits[:size]
+		if _, ok := phoneCountryCodes[code]; !ok {
+			continue
+		}
+
+		national := digits[size:]
+		bounds, ok := phoneNationalLengths[code]
+		if !ok {
+			bounds = [2]int{4, 15 - size}
+		}
+		if len(national) < bounds[0] || len(national) > bounds[1] {
+			return false
+		}
+
+		return code != "1" || national[0] >= '2' && national[3] >= '2'
+	}
+
+	return false
+}
+
+// validSSN отбрасывает номера, которые не выдаются: область 000, 666 и 900–999,
+// группа 00 и серийный номер 0000.
+func validSSN(candidate string) bool {
+	area, group, serial := candidate[:3], candidate[4:6], candidate[7:]
+	return area != "000" && area != "666" && area[0] != '9' && group != "00" && serial != "0000"
+}
+
+func validINN(candidate string) bool {
+	check := func(digits string, weights []int) byte {
+		sum := 0
+		for i, w := range weights {
+			sum += int(digits[i]-'0') * w
+		}
+		return byte(sum%11%10) + '0'
+	}
+
+	switch len(candidate) {
+	case 10:
+		return candidate[9] == check(candidate, []int{2, 4, 10, 3, 5, 9, 4, 6, 8})
+	case 12:
+		return candidate[10] == check(candidate, []int{7, 2, 4, 10, 3, 5, 9, 4, 6, 8}) &&
+			candidate[11] == check(candidate, []int{3, 7, 2, 4, 10, 3, 5, 9, 4, 6, 8})
+	}
+
+	return false
+}
+
+// validSNILS проверяет контрольное число: сумма цифр номера с весами 9..1 по модулю 101,
+// где 100 и 101 дают 00.
+func validSNILS(candidate string) bool {
+	digits := digitsOnly(candidate)
+	sum := 0
+	for i := 0; i < 9; i++ {
+		sum += int(digits[i]-'0') * (9 - i)
+	}
+
+	sum %= 101
+	if sum == 100 {
+		sum = 0
+	}
+
+	return fmt.Sprintf("%02d", sum) == digits[9:]
+}
@@ -0,0 +1,636 @@
+package analyzer
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/token"
+	"go/types"
+	"path"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/types/typeutil"
+)
+
+// redactFunc — функция маскирования, в которую автоисправление правила sensitive
+// оборачивает чувствительное значение: redact.String(password).
+type redactFunc struct {
+	pkg  string
+	name string
+}
+
+// parseRedactFunc разбирает полное имя функции вида "example.com/app/redact.String".
+func parseRedactFunc(spec string) (redactFunc, error) {
+	spec = strings.TrimSpace(spec)
+	if spec == "" {
+		return redactFunc{}, nil
+	}
+
+	dot := strings.LastIndex(spec, ".")
+	if dot <= 0 || dot < strings.LastIndex(spec, "/") || dot == len(spec)-1 {
+		return redactFunc{}, fmt.Errorf("redact func %q: want a package path and a function name, e.g. example.com/app/redact.String", spec)
+	}
+
+	return redactFunc{pkg: spec[:dot], name: spec[dot+1:]}, nil
+}
+
+func (f redactFunc) enabled() bool {
+	return f.name != ""
+}
+
+// signature ищет функцию маскирования среди зависимостей пакета и возвращает ее
+// сигнатуру. Функция, которую не найти, обобщенная или не с одним параметром и одним
+// результатом, не подходит: значения тогда убираются из сообщения.
+func (f redactFunc) signature(pkg *types.Package) *types.Signature {
+	seen := make(map[*types.Package]bool)
+	var find func(*types.Package) *types.Package
+	find = func(p *types.Package) *types.Package {
+		if p.Path() == f.pkg {
+			return p
+		}
+		if seen[p] {
+			return nil
+		}
+		seen[p] = true
+
+		for _, imported := range p.Imports() {
+			if found := find(imported); found != nil {
+				return found
+			}
+		}
+		return nil
+	}
+
+	found := find(pkg)
+	if found == nil {
+		return nil
+	}
+
+	fn, ok := found.Scope().Lookup(f.name).(*types.Func)
+	if !ok {
+		return nil
+	}
+
+	sig := fn.Signature()
+	if sig.TypeParams().Len() > 0 || sig.Variadic() || sig.Params().Len() != 1 || sig.Results().Len() != 1 {
+		return nil
+	}
+
+	return sig
+}
+
+// isCall сообщает, является ли expr вызовом функции маскирования.
+func (f redactFunc) isCall(pass *analysis.Pass, expr ast.Expr) bool {
+	if !f.enabled() {
+		return false
+	}
+
+	call, ok := ast.Unparen(expr).(*ast.CallExpr)
+	if !ok {
+		return false
+	}
+
+	fn, ok := typeutil.Callee(pass.TypesInfo, call).(*types.Func)
+	return ok && fn.Pkg() != nil && fn.Pkg().Path() == f.pkg && fn.Name() == f.name
+}
+
+// concatOperands раскладывает конкатенацию строк на операнды.
+func concatOperands(expr ast.Expr) []ast.Expr {
+	switch e := expr.(type) {
+	case *ast.BinaryExpr:
+		if e.Op == token.ADD {
+			return append(concatOperands(e.X), concatOperands(e.Y)...)
+		}
+	case *ast.ParenExpr:
+		return concatOperands(e.X)
+	}
+
+	return []ast.Expr{expr}
+}
+
+// messageOperands возвращает операнды аргументов сообщения без вызовов функции
+// маскирования и сообщает, остались ли среди них динамические значения и были ли
+// среди операндов замаскированные.
+func (r *runner) messageOperands(pass *analysis.Pass, msg logMessage) (operands []ast.Expr, dynamic, redacted bool) {
+	for _, arg := range msg.args {
+		for _, operand := range concatOperands(arg) {
+			if r.redact.isCall(pass, operand) {
+				redacted = true
+				continue
+			}
+
+			operands = append(operands, operand)
+			if _, ok := constantString(pass, operand); !ok {
+				dynamic = true
+			}
+		}
+	}
+
+	return operands, dynamic, redacted
+}
+
+// redaction — найденное в сообщении чувствительное значение и его подпись.
+type redaction struct {
+	// value — выражение со значением.
+	value ast.Expr
+	// label — индекс текстового сегмента с подписью значения или -1.
+	label int
+	// cut — смещение в тексте подписи, с которого начинается подпись ("password: ").
+	cut int
+	// match — детектор, по которому значение признано чувствительным.
+	match sensitiveMatch
+	// verb — глагол printf-формата для значения; пусто для операнда конкатенации.
+	verb string
+}
+
+// findRedaction проверяет динамическое значение сообщения: чувствительным считается
+// значение с подозрительным идентификатором или значение после подписи с ключевым словом.
+// text — текст сегмента перед значением.
+func (r *runner) findRedaction(pass *analysis.Pass, value ast.Expr, label int, text string) (redaction, bool) {
+	if _, ok := constantString(pass, value); ok || r.redact.isCall(pass, value) {
+		return redaction{}, false
+	}
+
+	result := redaction{value: value, label: label, cut: -1}
+	if label >= 0 {
+		var pattern sensitivePattern
+		if result.cut, pattern = labelStart(text, r.sensitivePatterns); result.cut >= 0 {
+			result.match = sensitiveMatch{detector: pattern.detector(), fragment: text}
+		}
+	}
+
+	if match, named := findSensitiveIdentifier(value, r.sensitivePatterns); named {
+		result.match = match
+	} else if result.cut < 0 {
+		return redaction{}, false
+	}
+
+	return result, true
+}
+
+// labelStart возвращает начало слова с ключевым словом в тексте подписи или -1.
+func labelStart(text string, patterns []sensitivePattern) (int, sensitivePattern) {
+	normalized := normalizeForSearch(text)
+	if len(normalized) != len(text) {
+		return -1, sensitivePattern{}
+	}
+
+	for _, pattern := range patterns {
+		idx := strings.LastIndex(normalized, pattern.text)
+		if idx < 0 {
+			continue
+		}
+
+		for idx > 0 && (unicode.IsLetter(rune(text[idx-1])) || unicode.IsDigit(rune(text[idx-1]))) {
+			idx--
+		}
+		return idx, pattern
+	}
+
+	return -1, sensitivePattern{}
+}
+
+// cutLabel убирает из текста подпись удаленного значения и разделители перед ним.
+func cutLabel(text string, cut int) string {
+	if cut >= 0 {
+		text = text[:cut]
+	}
+
+	return strings.TrimRight(text, " \t:=,;-")
+}
+
+// joinText склеивает текст по обе стороны от удаленного значения так, чтобы слова не слиплись.
+func joinText(left, right string) string {
+	if left == "" || right == "" || strings.HasSuffix(left, " ") || strings.HasPrefix(right, " ") {
+		return left + right
+	}
+
+	return left + " " + right
+}
+
+// buildSensitiveDataFix убирает из сообщения только чувствительные значения вместе с их
+// подписью ("user password: " + password → "user") или, если задана функция маскирования,
+// оборачивает значения в нее. Если значения не выделить, сообщение заменяется нейтральным.
+func (r *runner) buildSensitiveDataFix(pass *analysis.Pass, msg logMessage) (analysis.SuggestedFix, bool) {
+	var (
+		fix analysis.SuggestedFix
+		ok  bool
+	)
+	switch {
+	case msg.kind == messagePrintf:
+		fix, ok = r.buildPrintfRedactionFix(pass, msg)
+	case len(msg.args) == 1:
+		fix, ok = r.buildConcatRedactionFix(pass, msg.args[0])
+	}
+	if ok {
+		return fix, true
+	}
+
+	return buildReplaceMessageExprFix(msg, "sensitive data redacted", "replace with neutral message")
+}
+
+// findRedactions возвращает чувствительные значения конкатенации или printf-сообщения.
+// ok == false, если значения сообщения не сопоставить его тексту.
+func (r *runner) findRedactions(pass *analysis.Pass, msg logMessage) ([]redaction, bool) {
+	switch {
+	case msg.kind == messagePrintf:
+		layout, ok := r.printfRedactions(pass, msg)
+		return layout.redactions, ok
+	case len(msg.args) == 1:
+		_, redactions := r.concatRedactions(pass, msg.args[0])
+		return redactions, true
+	}
+
+	return nil, false
+}
+
+// concatSegment — операнд конкатенации: строковый литерал или выражение.
+type concatSegment struct {
+	expr    ast.Expr
+	text    string
+	literal bool
+}
+
+// concatRedactions раскладывает конкатенацию на сегменты и находит чувствительные значения.
+func (r *runner) concatRedactions(pass *analysis.Pass, expr ast.Expr) ([]concatSegment, []redaction) {
+	var segments []concatSegment
+	for _, operand := range concatOperands(expr) {
+		segment := concatSegment{expr: operand}
+		if lit, ok := operand.(*ast.BasicLit); ok && lit.Kind == token.STRING {
+			if text, err := strconv.Unquote(lit.Value); err == nil {
+				segment.text, segment.literal = text, true
+			}
+		}
+		segments = append(segments, segment)
+	}
+
+	var redactions []redaction
+	for i, segment := range segments {
+		if segment.literal {
+			continue
+		}
+
+		label, text := -1, ""
+		if i > 0 && segments[i-1].literal {
+			label, text = i-1, segments[i-1].text
+		}
+		if found, ok := r.findRedaction(pass, segment.expr, label, text); ok {
+			redactions = append(redactions, found)
+		}
+	}
+
+	return segments, redactions
+}
+
+func (r *runner) buildConcatRedactionFix(pass *analysis.Pass, expr ast.Expr) (analysis.SuggestedFix, bool) {
+	segments, redactions := r.concatRedactions(pass, expr)
+	if len(redactions) == 0 {
+		return analysis.SuggestedFix{}, false
+	}
+
+	wrapped, removed := r.splitRedactions(pass, redactions)
+	if len(removed) == 0 {
+		return r.wrapRedactions(pass, wrapped)
+	}
+
+	for _, found := range removed {
+		if found.label >= 0 {
+			segments[found.label].text = cutLabel(segments[found.label].text, found.cut)
+		}
+	}
+
+	isRemoved, isWrapped := redactionSet(removed), redactionSet(wrapped)
+	var (
+		kept    []concatSegment
+		trimmed bool
+	)
+	for _, segment := range segments {
+		switch {
+		case isRemoved[segment.expr]:
+			trimmed = true
+			continue
+		case segment.literal && len(kept) > 0 && kept[len(kept)-1].literal:
+			last := &kept[len(kept)-1]
+			if trimmed {
+				last.text = joinText(last.text, segment.text)
+			} else {
+				last.text += segment.text
+			}
+		default:
+			kept = append(kept, segment)
+		}
+		trimmed = false
+	}
+
+	var (
+		edits []analysis.TextEdit
+		name  string
+	)
+	if len(wrapped) > 0 {
+		name, edits = r.redactQualifier(pass, fileOf(pass, expr.Pos()))
+	}
+
+	var parts []string
+	for i, segment := range kept {
+		if !segment.literal {
+			text := nodeText(pass, segment.expr)
+			if isWrapped[segment.expr] {
+				text = name + "(" + text + ")"
+			}
+			parts = append(parts, text)
+			continue
+		}
+
+		if i == 0 {
+			segment.text = strings.TrimLeft(segment.text, " ")
+		}
+		if i == len(kept)-1 {
+			segment.text = strings.TrimRight(segment.text, " ")
+		}
+		if segment.text != "" {
+			parts = append(parts, strconv.Quote(segment.text))
+		}
+	}
+	if len(parts) == 0 {
+		return analysis.SuggestedFix{}, false
+	}
+
+	edits = append(edits, analysis.TextEdit{Pos: expr.Pos(), End: expr.End(), NewText: []byte(strings.Join(parts, " + "))})
+	return analysis.SuggestedFix{Message: "remove sensitive value from message", TextEdits: edits}, true
+}
+
+// printfLayout — printf-формат, сопоставленный аргументам сообщения.
+type printfLayout struct {
+	lit      *ast.BasicLit
+	segments []formatSegment
+	// verbArgs сопоставляет индексу глагола в segments индекс его аргумента в msg.args.
+	verbArgs   map[int]int
+	redactions []redaction
+}
+
+// printfRedactions разбирает литеральный формат и находит чувствительные аргументы.
+func (r *runner) printfRedactions(pass *analysis.Pass, msg logMessage) (printfLayout, bool) {
+	lit, ok := msg.args[0].(*ast.BasicLit)
+	if !ok || lit.Kind != token.STRING {
+		return printfLayout{}, false
+	}
+
+	formatText, err := strconv.Unquote(lit.Value)
+	if err != nil {
+		return printfLayout{}, false
+	}
+
+	// Глаголы с явным индексом аргумента или шириной из аргумента не сопоставить
+	// аргументам по порядку.
+	segments := splitFormat(formatText)
+	verbs := 0
+	for _, segment := range segments {
+		if segment.verb {
+			if strings.ContainsAny(segment.text, "*[") {
+				return printfLayout{}, false
+			}
+			verbs++
+		}
+	}
+	if verbs != len(msg.args)-1 {
+		return printfLayout{}, false
+	}
+
+	var (
+		redactions []redaction
+		verbArgs   = make(map[int]int)
+		arg        = 1
+	)
+	for i, segment := range segments {
+		if !segment.verb {
+			continue
+		}
+
+		verbArgs[i] = arg
+		label, text := -1, ""
+		if i > 0 && !segments[i-1].verb {
+			label, text = i-1, segments[i-1].text
+		}
+		if found, ok := r.findRedaction(pass, msg.args[arg], label, text); ok {
+			found.verb = segment.text
+			redactions = append(redactions, found)
+		}
+		arg++
+	}
+
+	return printfLayout{lit: lit, segments: segments, verbArgs: verbArgs, redactions: redactions}, true
+}
+
+func (r *runner) buildPrintfRedactionFix(pass *analysis.Pass, msg logMessage) (analysis.SuggestedFix, bool) {
+	layout, ok := r.printfRedactions(pass, msg)
+	if !ok || len(layout.redactions) == 0 {
+		return analysis.SuggestedFix{}, false
+	}
+
+	wrapped, removed := r.splitRedactions(pass, layout.redactions)
+	if len(removed) == 0 {
+		return r.wrapRedactions(pass, wrapped)
+	}
+
+	segments, verbArgs := layout.segments, layout.verbArgs
+	for _, found := range removed {
+		if found.label >= 0 {
+			segments[found.label].text = cutLabel(segments[found.label].text, found.cut)
+		}
+	}
+
+	// format — новый формат с экранированными %, text — тот же текст без экранирования
+	// на случай, если аргументов не останется.
+	var (
+		format, text strings.Builder
+		edits        []analysis.TextEdit
+		left         string
+		isRemoved    = redactionSet(removed)
+	)
+	flush := func() {
+		format.WriteString(strings.ReplaceAll(left, "%", "%%"))
+		text.WriteString(left)
+		left = ""
+	}
+	for i, segment := range segments {
+		switch {
+		case !segment.verb:
+			left += segment.text
+		case isRemoved[msg.args[verbArgs[i]]]:
+			index := verbArgs[i]
+			edits = append(edits, analysis.TextEdit{Pos: msg.args[index-1].End(), End: msg.args[index].End()})
+			if i+1 < len(segments) && !segments[i+1].verb {
+				segments[i+1].text = joinText(left, segments[i+1].text)
+				left = ""
+			}
+		default:
+			flush()
+			format.WriteString(segment.text)
+			text.WriteString(segment.text)
+		}
+	}
+	flush()
+
+	fixed := strings.TrimSpace(format.String())
+	if fixed == "" {
+		return analysis.SuggestedFix{}, false
+	}
+
+	// fmt.Sprintf без аргументов не нужен: он заменяется самим текстом.
+	if msg.sprint != nil && len(removed) == len(msg.args)-1 {
+		edits = []analysis.TextEdit{{Pos: msg.Pos(), End: msg.End(), NewText: []byte(strconv.Quote(strings.TrimSpace(text.String())))}}
+		return analysis.SuggestedFix{Message: "remove sensitive value from message", TextEdits: edits}, true
+	}
+
+	if len(wrapped) > 0 {
+		edits = append(edits, r.wrapEdits(pass, wrapped)...)
+	}
+
+	edits = append(edits, analysis.TextEdit{Pos: layout.lit.Pos(), End: layout.lit.End(), NewText: []byte(strconv.Quote(fixed))})
+	return analysis.SuggestedFix{Message: "remove sensitive value from message", TextEdits: edits}, true
+}
+
+func redactionSet(redactions []redaction) map[ast.Expr]bool {
+	set := make(map[ast.Expr]bool, len(redactions))
+	for _, found := range redactions {
+		set[found.value] = true
+	}
+
+	return set
+}
+
+// splitRedactions делит значения на те, что можно обернуть в функцию маскирования,
+// и те, что придется убрать из сообщения.
+func (r *runner) splitRedactions(pass *analysis.Pass, redactions []redaction) (wrapped, removed []redaction) {
+	if !r.redact.enabled() || fileOf(pass, redactions[0].value.Pos()) == nil {
+		return nil, redactions
+	}
+
+	sig := r.redact.signature(pass.Pkg)
+	for _, found := range redactions {
+		if sig != nil && canWrap(pass, sig, found) {
+			wrapped = append(wrapped, found)
+		} else {
+			removed = append(removed, found)
+		}
+	}
+
+	return wrapped, removed
+}
+
+// canWrap сообщает, примет ли функция маскирования значение и подойдет ли ее результат
+// на место значения: в конкатенацию строк или под глагол printf.
+func canWrap(pass *analysis.Pass, sig *types.Signature, found redaction) bool {
+	value := pass.TypesInfo.TypeOf(found.value)
+	if value == nil || !types.AssignableTo(value, sig.Params().At(0).Type()) {
+		return false
+	}
+
+	result := sig.Results().At(0).Type()
+	if found.verb == "" {
+		return types.AssignableTo(result, value)
+	}
+
+	switch found.verb[len(found.verb)-1] {
+	case 'v':
+		return true
+	case 's', 'q':
+		if basic, ok := result.Underlying().(*types.Basic); ok && basic.Info()&types.IsString != 0 {
+			return true
+		}
+	}
+
+	return types.Identical(result, value)
+}
+
+// wrapRedactions оборачивает значения в функцию маскирования и при необходимости
+// добавляет импорт ее пакета.
+func (r *runner) wrapRedactions(pass *analysis.Pass, redactions []redaction) (analysis.SuggestedFix, bool) {
+	edits := r.wrapEdits(pass, redactions)
+	name, _ := r.redactQualifier(pass, fileOf(pass, redactions[0].value.Pos()))
+
+	return analysis.SuggestedFix{
+		Message:   "wrap sensitive value in " + name,
+		TextEdits: edits,
+	}, true
+}
+
+// wrapEdits возвращает правки, которые оборачивают значения в функцию маскирования
+// и импортируют ее пакет.
+func (r *runner) wrapEdits(pass *analysis.Pass, redactions []redaction) []analysis.TextEdit {
+	name, edits := r.redactQualifier(pass, fileOf(pass, redactions[0].value.Pos()))
+	for _, found := range redactions {
+		edits = append(edits,
+			analysis.TextEdit{Pos: found.value.Pos(), End: found.value.Pos(), NewText: []byte(name + "(")},
+			analysis.TextEdit{Pos: found.value.End(), End: found.value.End(), NewText: []byte(")")},
+		)
+	}
+
+	return edits
+}
+
+// redactQualifier возвращает имя функции маскирования в файле ("redact.String")
+// и правку, которая импортирует ее пакет, если файл его еще не импортирует.
+func (r *runner) redactQualifier(pass *analysis.Pass, file *ast.File) (string, []analysis.TextEdit) {
+	if r.redact.pkg == pass.Pkg.Path() {
+		return r.redact.name, nil
+	}
+
+	for _, spec := range file.Imports {
+		if importPath, err := strconv.Unquote(spec.Path.Value); err != nil || importPath != r.redact.pkg {
+			continue
+		}
+
+		switch {
+		case spec.Name != nil && spec.Name.Name == ".":
+			return r.redact.name, nil
+		case spec.Name != nil && spec.Name.Name != "_":
+			return spec.Name.Name + "." + r.redact.name, nil
+		case spec.Name == nil:
+			if pkgName := pass.TypesInfo.PkgNameOf(spec); pkgName != nil {
+				return pkgName.Imported().Name() + "." + r.redact.name, nil
+			}
+		}
+	}
+
+	name := path.Base(r.redact.pkg) + "." + r.redact.name
+	importLine := strconv.Quote(r.redact.pkg)
+	for _, decl := range file.Decls {
+		gen, ok := decl.(*ast.GenDecl)
+		if !ok || gen.Tok != token.IMPORT {
+			continue
+		}
+
+		if gen.Lparen.IsValid() {
+			return name, []analysis.TextEdit{{Pos: gen.Rparen, End: gen.Rparen, NewText: []byte("\n\t" + importLine + "\n")}}
+		}
+		return name, []analysis.TextEdit{{Pos: gen.End(), End: gen.End(), NewText: []byte("\nimport " + importLine)}}
+	}
+
+	return name, []analysis.TextEdit{{Pos: file.Name.End(), End: file.Name.End(), NewText: []byte("\n\nimport " + importLine)}}
+}
+
+// fileOf возвращает файл пакета, которому принадлежит pos.
+func fileOf(pass *analysis.Pass, pos token.Pos) *ast.File {
+	for _, file := range pass.Files {
+		if file.FileStart <= pos && pos <= file.FileEnd {
+			return file
+		}
+	}
+
+	return nil
+}
+
+// nodeText печатает выражение так, как его отформатировал бы gofmt.
+func nodeText(pass *analysis.Pass, node ast.Node) string {
+	var b bytes.Buffer
+	if err := format.Node(&b, pass.Fset, node); err != nil {
+		return ""
+	}
+
+	return b.String()
+}
@@ -0,0 +1,185 @@
+package analyzer
+
+import (
+	"errors"
+	"fmt"
+	"go/types"
+	"strings"
+)
+
+// Способы сборки сообщения, которые можно указать в LoggerSpec.Kind.
+const (
+	// KindPlain — сообщение передается одним аргументом или склеивается
+	// из variadic-хвоста через fmt.Sprint.
+	KindPlain = "plain"
+	// KindPrintln — variadic-хвост склеивается через fmt.Sprintln.
+	KindPrintln = "println"
+	// KindPrintf — аргумент сообщения является форматом fmt.Sprintf.
+	KindPrintf = "printf"
+	// KindKeyValue — за сообщением следуют пары ключ/значение.
+	KindKeyValue = "key-value"
+)
+
+// LoggerSpec описывает методы логгера, сообщения которых проверяет loglint.
+type LoggerSpec struct {
+	// Package — путь импорта пакета, в котором объявлен логгер.
+	Package string
+	// Type — имя типа логгера; пустое значение означает функции пакетного уровня.
+	Type string
+	// Methods — имена методов типа или функций пакета.
+	Methods []string
+	// MessageIndex — индекс аргумента с сообщением.
+	MessageIndex int
+	// Kind — способ сборки сообщения: plain, println, printf или key-value.
+	// Пустое значение равносильно plain.
+	Kind string
+}
+
+// loggerKey идентифицирует функцию или метод логгера.
+type loggerKey struct {
+	pkg    string
+	typ    string
+	method string
+}
+
+type loggerMethod struct {
+	msgIndex int
+	kind     messageKind
+}
+
+// buildRegistry объединяет встроенные описания логгеров с пользовательскими.
+// Пользовательская запись для того же метода заменяет встроенную.
+func buildRegistry(specs []LoggerSpec) (map[loggerKey]loggerMethod, error) {
+	registry := make(map[loggerKey]loggerMethod)
+	for _, spec := range defaultLoggers() {
+		if err := registerLogger(registry, spec); err != nil {
+			return nil, err
+		}
+	}
+
+	for i, spec := range specs {
+		if err := registerLogger(registry, spec); err != nil {
+			return nil, fmt.Errorf("logger %d: %w", i, err)
+		}
+	}
+
+	return registry, nil
+}
+
+func registerLogger(registry map[loggerKey]loggerMethod, spec LoggerSpec) error {
+	kind, ok := parseMessageKind(spec.Kind)
+	switch {
+	case !ok:
+		return fmt.Errorf("unknown kind %q", spec.Kind)
+	case spec.Package == "":
+		return errors.New("package is required")
+	case spec.MessageIndex < 0:
+		return errors.New("message index must not be negative")
+	}
+
+	for _, method := range spec.Methods {
+		method = strings.TrimSpace(method)
+		if method == "" {
+			continue
+		}
+
+		key := loggerKey{pkg: spec.Package, typ: spec.Type, method: method}
+		registry[key] = loggerMethod{msgIndex: spec.MessageIndex, kind: kind}
+	}
+
+	return nil
+}
+
+func parseMessageKind(kind string) (messageKind, bool) {
+	switch strings.TrimSpace(strings.ToLower(kind)) {
+	case "", KindPlain:
+		return messagePlain, true
+	case KindPrintln:
+		return messageLine, true
+	case KindPrintf:
+		return messagePrintf, true
+	case KindKeyValue:
+		return messageKeyValue, true
+	}
+
+	return messagePlain, false
+}
+
+// calleeKey строит ключ реестра для вызываемой функции или метода.
+// Для методов используется тип, в котором метод объявлен, поэтому вызовы
+// через встроенные поля и интерфейсы распознаются так же, как прямые.
+func calleeKey(fn *types.Func) (loggerKey, bool) {
+	if fn.Pkg() == nil {
+		return loggerKey{}, false
+	}
+
+	sig, ok := fn.Type().(*types.Signature)
+	if !ok {
+		return loggerKey{}, false
+	}
+
+	if sig.Recv() == nil {
+		return loggerKey{pkg: fn.Pkg().Path(), method: fn.Name()}, true
+	}
+
+	named := namedType(sig.Recv().Type())
+	if named == nil || named.Obj() == nil || named.Obj().Pkg() == nil {
+		return loggerKey{}, false
+	}
+
+	return loggerKey{
+		pkg:    named.Obj().Pkg().Path(),
+		typ:    named.Obj().Name(),
+		method: fn.Name(),
+	}, true
+}
+
+func defaultLoggers() []LoggerSpec {
+	slogLevels := []string{"Debug", "Info", "Warn", "Error"}
+	slogContextLevels := []string{"DebugContext", "InfoContext", "WarnContext", "ErrorContext"}
+	zapLevels := []string{"Debug", "Info", "Warn", "Error", "DPanic", "Panic", "Fatal"}
+	logrusLevels := []string{"Trace", "Debug", "Info", "Print", "Warn", "Warning", "Error", "Fatal", "Panic"}
+
+	specs := []LoggerSpec{
+		{Package: "log/slog", Methods: slogLevels, MessageIndex: 0, Kind: KindKeyValue},
+		{Package: "log/slog", Methods: slogContextLevels, MessageIndex: 1, Kind: KindKeyValue},
+		{Package: "log/slog", Type: "Logger", Methods: slogLevels, MessageIndex: 0, Kind: KindKeyValue},
+		{Package: "log/slog", Type: "Logger", Methods: slogContextLevels, MessageIndex: 1, Kind: KindKeyValue},
+		{Package: "go.uber.org/zap", Type: "Logger", Methods: zapLevels, MessageIndex: 0, Kind: KindPlain},
+		{Package: "go.uber.org/zap", Type: "SugaredLogger", Methods: zapLevels, MessageIndex: 0, Kind: KindPlain},
+		{Package: "go.uber.org/zap", Type: "SugaredLogger", Methods: withSuffix(zapLevels, "f"), MessageIndex: 0, Kind: KindPrintf},
+		{Package: "go.uber.org/zap", Type: "SugaredLogger", Methods: withSuffix(zapLevels, "ln"), MessageIndex: 0, Kind: KindPrintln},
+		{Package: "go.uber.org/zap", Type: "SugaredLogger", Methods: withSuffix(zapLevels, "w"), MessageIndex: 0, Kind: KindKeyValue},
+		{Package: zerologPkgPath, Type: "Event", Methods: []string{"Msg"}, MessageIndex: 0, Kind: KindPlain},
+		{Package: zerologPkgPath, Type: "Event", Methods: []string{"Msgf"}, MessageIndex: 0, Kind: KindPrintf},
+	}
+
+	// Набор уровневых методов logrus одинаков для функций пакета, *logrus.Logger и *logrus.Entry.
+	for _, typ := range []string{"", "Logger", "Entry"} {
+		specs = append(specs,
+			LoggerSpec{Package: "github.com/sirupsen/logrus", Type: typ, Methods: logrusLevels, MessageIndex: 0, Kind: KindPlain},
+			LoggerSpec{Package: "github.com/sirupsen/logrus", Type: typ, Methods: withSuffix(logrusLevels, "f"), MessageIndex: 0, Kind: KindPrintf},
+			LoggerSpec{Package: "github.com/sirupsen/logrus", Type: typ, Methods: withSuffix(logrusLevels, "ln"), MessageIndex: 0, Kind: KindPrintln},
+		)
+	}
+
+	// Log/Logf/Logln первым аргументом принимают уровень логирования.
+	for _, typ := range []string{"Logger", "Entry"} {
+		specs = append(specs,
+			LoggerSpec{Package: "github.com/sirupsen/logrus", Type: typ, Methods: []string{"Log"}, MessageIndex: 1, Kind: KindPlain},
+			LoggerSpec{Package: "github.com/sirupsen/logrus", Type: typ, Methods: []string{"Logf"}, MessageIndex: 1, Kind: KindPrintf},
+			LoggerSpec{Package: "github.com/sirupsen/logrus", Type: typ, Methods: []string{"Logln"}, MessageIndex: 1, Kind: KindPrintln},
+		)
+	}
+
+	return specs
+}
+
+func withSuffix(names []string, suffix string) []string {
+	result := make([]string, 0, len(names))
+	for _, name := range names {
+		result = append(result, name+suffix)
+	}
+
+	return result
+}
@@ -0,0 +1,79 @@
+package analyzer
+
+// RuleDoc описывает правило для отчетов: SARIF, документации и т. п.
+type RuleDoc struct {
+	// Name — имя правила, как в disabled_rules и Diagnostic.Category.
+	Name string
+	// Short — однострочное описание.
+	Short string
+	// Full — подробное описание того, что проверяет правило.
+	Full string
+	// Help — как исправить находку.
+	Help string
+}
+
+// ruleDocs — описания правил из allRules.
+var ruleDocs = map[string]RuleDoc{
+	ruleLowercase: {
+		Short: "Log message starts with an uppercase letter",
+		Full:  "Log messages should start with a lowercase letter so that they read uniformly and can be grepped reliably.",
+		Help:  "Lowercase the first letter of the message, e.g. slog.Info(\"starting server\").",
+	},
+	ruleEnglish: {
+		Short: "Log message is not in English",
+		Full:  "Log messages should contain only English text.",
+		Help:  "Rewrite the message in English.",
+	},
+	ruleSpecialChars: {
+		Short: "Log message contains special characters or emoji",
+		Full:  "Log messages should not contain repeated punctuation, special symbols or emoji.",
+		Help:  "Remove special characters and emoji from the message.",
+	},
+	ruleSensitive: {
+		Short: "Log message may contain sensitive data",
+		Full:  "The log message contains a sensitive keyword, a match of a custom pattern or concatenates data next to a sensitive label, such as a password or token.",
+		Help:  "Do not log secrets or personal data; log a non-sensitive identifier or a fact about the value instead.",
+	},
+	ruleSensitiveAttrs: {
+		Short: "Structured log attribute may contain sensitive data",
+		Full:  "A key or a value identifier of a structured attribute (slog.Attr, zap.Field, key/value pairs, logrus fields, zerolog chains) looks like sensitive data.",
+		Help:  "Drop the attribute or log a non-sensitive derivative of the value.",
+	},
+	ruleKeyStyle: {
+		Short: "Attribute key does not follow the configured style",
+		Full:  "Attribute keys should follow the naming style set by key_style: snake_case, camelCase, kebab-case or dotted.",
+		Help:  "Rename the key to the configured style.",
+	},
+	ruleKeyValuePairs: {
+		Short: "Malformed key/value pairs",
+		Full:  "Key/value arguments of slog and SugaredLogger.*w calls must come in pairs with unique string keys that do not clash with the reserved keys time, level, msg and source.",
+		Help:  "Add the missing value, use a string key or rename the duplicate key.",
+	},
+	ruleDirectives: {
+		Short: "Malformed or unused loglint directive",
+		Full:  "A //loglint:ignore or //loglint:file-ignore directive has no reason, names an unknown rule or suppresses nothing.",
+		Help:  "Fix the directive or remove it.",
+	},
+	ruleBaseline: {
+		Short: "Stale baseline entry",
+		Full:  "A baseline entry no longer matches any finding.",
+		Help:  "Rewrite the baseline with `loglint baseline write`.",
+	},
+	ruleTaint: {
+		Short: "Secret flows into a log call",
+		Full:  "A value read from a secret source (HTTP headers and cookies, environment variables, configured secret fields, database DSNs) reaches a log argument, possibly through variables, formatting and function calls in other packages.",
+		Help:  "Do not log the secret; log a non-sensitive derivative such as its presence or length.",
+	},
+}
+
+// Rules возвращает описания всех правил в порядке allRules.
+func Rules() []RuleDoc {
+	docs := make([]RuleDoc, 0, len(allRules))
+	for _, rule := range allRules {
+		doc := ruleDocs[rule]
+		doc.Name = rule
+		docs = append(docs, doc)
+	}
+
+	return docs
+}
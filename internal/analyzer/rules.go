@@ -7,6 +7,7 @@ import (
 	"unicode"
 	"unicode/utf8"
 
+	"github.com/victornechaev/loglint/internal/config"
 	"golang.org/x/tools/go/analysis"
 )
 
@@ -15,82 +16,146 @@ const (
 	ruleEnglish      = "english"
 	ruleSpecialChars = "specialchars"
 	ruleSensitive    = "sensitive"
+	// ruleSensitiveAttrs проверяет ключи и значения структурированных атрибутов.
+	ruleSensitiveAttrs = "sensitiveattrs"
+	// ruleKeyStyle проверяет стиль именования ключей атрибутов (включается через KeyStyle).
+	ruleKeyStyle = "keystyle"
+	// ruleKeyValuePairs проверяет корректность хвоста пар ключ/значение.
+	ruleKeyValuePairs = "kvpairs"
+	// ruleDirectives сообщает о некорректных и неиспользуемых директивах //loglint:.
+	ruleDirectives = "directives"
+	// ruleBaseline сообщает о записях baseline, которые больше не воспроизводятся.
+	ruleBaseline = "baseline"
+	// ruleTaint сообщает о секретах, попадающих в логи по потоку данных (включается через Taint).
+	ruleTaint = "taint"
 )
 
+// allRules — все правила анализатора в порядке их описания в README. Список общий
+// с config, чтобы проверка конфига и NewAnalyzer принимали одни и те же имена.
+var allRules = config.Rules
+
+func isKnownRule(rule string) bool {
+	for _, known := range allRules {
+		if known == rule {
+			return true
+		}
+	}
+
+	return false
+}
+
 type ruleSpec struct {
 	name     string
 	message  string
-	failed   func(ast.Expr, messageData) bool
-	buildFix func(ast.Expr, messageData) (analysis.SuggestedFix, bool)
+	failed   func(logMessage, messageData) bool
+	buildFix func(logMessage, messageData) (analysis.SuggestedFix, bool)
 }
 
-func (r *runner) checkMessage(pass *analysis.Pass, msgExpr ast.Expr) {
-	data := collectMessageData(pass, msgExpr)
+func (r *runner) checkMessage(pass *analysis.Pass, msg logMessage) {
+	data := collectMessageData(pass, msg)
+	sensitive, hasSensitive := r.findSensitiveData(pass, msg, data)
 	textRules := []ruleSpec{
 		{
 			name:    ruleLowercase,
 			message: "log message should start with a lowercase letter",
-			failed: func(_ ast.Expr, d messageData) bool {
+			failed: func(_ logMessage, d messageData) bool {
 				return d.hasFullText && startsWithUpperASCII(d.fullText)
 			},
-			buildFix: func(expr ast.Expr, d messageData) (analysis.SuggestedFix, bool) {
-				return buildLowercaseFix(expr, d.fullText)
+			buildFix: func(msg logMessage, d messageData) (analysis.SuggestedFix, bool) {
+				return buildLowercaseFix(msg, d)
 			},
 		},
 		{
 			name:    ruleEnglish,
 			message: "log message should contain only English language",
-			failed: func(_ ast.Expr, d messageData) bool {
+			failed: func(_ logMessage, d messageData) bool {
 				return d.hasFullText && containsNonEnglishLetters(d.fullText)
 			},
-			buildFix: func(expr ast.Expr, d messageData) (analysis.SuggestedFix, bool) {
-				return buildEnglishOnlyFix(expr, d.fullText)
+			buildFix: func(msg logMessage, d messageData) (analysis.SuggestedFix, bool) {
+				return buildEnglishOnlyFix(msg, d)
 			},
 		},
 		{
 			name:    ruleSpecialChars,
 			message: "log message must not contain special symbols or emoji",
-			failed: func(_ ast.Expr, d messageData) bool {
+			failed: func(_ logMessage, d messageData) bool {
 				return d.hasFullText && containsSpecialSymbolsOrEmoji(d.fullText)
 			},
-			buildFix: func(expr ast.Expr, d messageData) (analysis.SuggestedFix, bool) {
-				return buildSpecialSymbolsFix(expr, d.fullText)
+			buildFix: func(msg logMessage, d messageData) (analysis.SuggestedFix, bool) {
+				return buildSpecialSymbolsFix(msg, d)
 			},
 		},
 		{
 			name:    ruleSensitive,
-			message: "log message may contain sensitive data",
-			failed: func(expr ast.Expr, d messageData) bool {
-				return r.containsSensitiveData(expr, d)
+			message: sensitive.describe("log message may contain sensitive data"),
+			failed: func(logMessage, messageData) bool {
+				return hasSensitive
 			},
-			buildFix: func(expr ast.Expr, _ messageData) (analysis.SuggestedFix, bool) {
-				return buildSensitiveDataFix(expr)
+			buildFix: func(msg logMessage, _ messageData) (analysis.SuggestedFix, bool) {
+				return r.buildSensitiveDataFix(pass, msg)
 			},
 		},
 	}
 
 	for _, spec := range textRules {
-		r.reportRuleViolation(pass, msgExpr, data, spec)
+		r.reportRuleViolation(pass, msg, data, spec)
 	}
 }
 
-func (r *runner) reportRuleViolation(pass *analysis.Pass, expr ast.Expr, data messageData, spec ruleSpec) {
-	if !r.ruleEnabled(spec.name) || !spec.failed(expr, data) {
+func (r *runner) checkAttrs(pass *analysis.Pass, attrs []logAttr) {
+	for _, attr := range attrs {
+		if key, ok := constantString(pass, attr.key); ok {
+			if pattern, ok := findPattern(key, r.sensitivePatterns); ok {
+				match := sensitiveMatch{detector: pattern.detector(), fragment: key}
+				r.report(pass, ruleSensitiveAttrs, analysis.Diagnostic{
+					Pos:     attr.key.Pos(),
+					End:     attr.key.End(),
+					Message: match.describe("log attribute key may contain sensitive data"),
+				})
+				continue
+			}
+		}
+
+		if attr.value == nil {
+			continue
+		}
+
+		if match, ok := findSensitiveIdentifier(attr.value, r.sensitivePatterns); ok {
+			r.report(pass, ruleSensitiveAttrs, analysis.Diagnostic{
+				Pos:     attr.value.Pos(),
+				End:     attr.value.End(),
+				Message: match.describe("log attribute value may contain sensitive data"),
+			})
+		}
+	}
+}
+
+func (r *runner) reportRuleViolation(pass *analysis.Pass, msg logMessage, data messageData, spec ruleSpec) {
+	if !r.ruleEnabled(spec.name) || !spec.failed(msg, data) {
 		return
 	}
 
 	diag := analysis.Diagnostic{
-		Pos:     expr.Pos(),
-		End:     expr.End(),
+		Pos:     msg.Pos(),
+		End:     msg.End(),
 		Message: spec.message,
 	}
 
 	if !r.disableFixes && spec.buildFix != nil {
-		if fix, ok := spec.buildFix(expr, data); ok {
+		if fix, ok := spec.buildFix(msg, data); ok {
 			diag.SuggestedFixes = []analysis.SuggestedFix{fix}
 		}
 	}
 
+	r.report(pass, spec.name, diag)
+}
+
+func (r *runner) report(pass *analysis.Pass, rule string, diag analysis.Diagnostic) {
+	if !r.ruleEnabled(rule) {
+		return
+	}
+
+	diag.Category = Category(rule, r.severity(rule))
 	pass.Report(diag)
 }
 
@@ -103,13 +168,13 @@ func startsWithUpperASCII(text string) bool {
 	return r >= 'A' && r <= 'Z'
 }
 
-func buildLowercaseFix(expr ast.Expr, original string) (analysis.SuggestedFix, bool) {
-	fixed, changed := lowercaseFirstASCII(original)
+func buildLowercaseFix(msg logMessage, data messageData) (analysis.SuggestedFix, bool) {
+	fixed, changed := lowercaseFirstASCII(data.sourceText())
 	if !changed {
 		return analysis.SuggestedFix{}, false
 	}
 
-	return buildReplaceMessageExprFix(expr, fixed, "convert first letter to lowercase")
+	return buildReplaceMessageExprFix(msg.textNode(), fixed, "convert first letter to lowercase")
 }
 
 func lowercaseFirstASCII(text string) (string, bool) {
@@ -152,7 +217,8 @@ func containsSpecialSymbolsOrEmoji(text string) bool {
 	return false
 }
 
-func buildEnglishOnlyFix(expr ast.Expr, original string) (analysis.SuggestedFix, bool) {
+func buildEnglishOnlyFix(msg logMessage, data messageData) (analysis.SuggestedFix, bool) {
+	original := data.sourceText()
 	fixed := strings.TrimSpace(filterEnglishLettersOnly(original))
 	if fixed == "" {
 		fixed = "message"
@@ -162,7 +228,7 @@ func buildEnglishOnlyFix(expr ast.Expr, original string) (analysis.SuggestedFix,
 		return analysis.SuggestedFix{}, false
 	}
 
-	return buildReplaceMessageExprFix(expr, fixed, "remove non-English letters")
+	return buildReplaceMessageExprFix(msg.textNode(), fixed, "remove non-English letters")
 }
 
 func filterEnglishLettersOnly(text string) string {
@@ -191,8 +257,12 @@ func filterEnglishLettersOnly(text string) string {
 	return strings.Join(strings.Fields(b.String()), " ")
 }
 
-func buildSpecialSymbolsFix(expr ast.Expr, original string) (analysis.SuggestedFix, bool) {
+func buildSpecialSymbolsFix(msg logMessage, data messageData) (analysis.SuggestedFix, bool) {
+	original := data.sourceText()
 	fixed := strings.TrimSpace(filterToAlphaNumSpace(original))
+	if msg.kind == messagePrintf {
+		fixed = filterFormatToAlphaNumSpace(original)
+	}
 	if fixed == "" {
 		fixed = "message"
 	}
@@ -201,7 +271,7 @@ func buildSpecialSymbolsFix(expr ast.Expr, original string) (analysis.SuggestedF
 		return analysis.SuggestedFix{}, false
 	}
 
-	return buildReplaceMessageExprFix(expr, fixed, "remove special symbols and emoji")
+	return buildReplaceMessageExprFix(msg.textNode(), fixed, "remove special symbols and emoji")
 }
 
 func filterToAlphaNumSpace(text string) string {
@@ -221,11 +291,7 @@ func filterToAlphaNumSpace(text string) string {
 	return strings.Join(strings.Fields(b.String()), " ")
 }
 
-func buildSensitiveDataFix(expr ast.Expr) (analysis.SuggestedFix, bool) {
-	return buildReplaceMessageExprFix(expr, "sensitive data redacted", "replace with neutral message")
-}
-
-func buildReplaceMessageExprFix(expr ast.Expr, fixed, message string) (analysis.SuggestedFix, bool) {
+func buildReplaceMessageExprFix(expr ast.Node, fixed, message string) (analysis.SuggestedFix, bool) {
 	if fixed == "" {
 		return analysis.SuggestedFix{}, false
 	}
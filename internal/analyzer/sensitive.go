@@ -1,38 +1,139 @@
 package analyzer
 
 import (
+	"fmt"
 	"go/ast"
 	"regexp"
 	"strings"
+	"unicode"
+
+	"golang.org/x/tools/go/analysis"
 )
 
-func (r *runner) containsSensitiveData(expr ast.Expr, data messageData) bool {
-	if data.hasFullText && containsCustomPattern(data.fullText, r.customPatterns) {
-		return true
+// sensitivePattern — ключевое слово чувствительных данных. builtin отличает
+// встроенные слова от добавленных через sensitive_patterns.
+type sensitivePattern struct {
+	text    string
+	builtin bool
+}
+
+func (p sensitivePattern) detector() string {
+	if p.builtin {
+		return "default pattern: " + p.text
 	}
 
-	if data.hasFullText && containsSensitiveAssignment(data.fullText, r.sensitivePatterns) {
-		return true
+	return "sensitive keyword: " + p.text
+}
+
+// customPattern — именованный regex из custom_patterns.
+type customPattern struct {
+	name string
+	re   *regexp.Regexp
+}
+
+// sensitiveMatch описывает сработавший детектор и фрагмент выражения,
+// на котором он сработал.
+type sensitiveMatch struct {
+	detector string
+	fragment string
+}
+
+func (m sensitiveMatch) describe(message string) string {
+	return fmt.Sprintf("%s (%s, matched %q)", message, m.detector, m.fragment)
+}
+
+func (r *runner) findSensitiveData(pass *analysis.Pass, msg logMessage, data messageData) (sensitiveMatch, bool) {
+	if data.hasFullText {
+		if match, ok := findCustomPattern(data.fullText, r.customPatterns); ok {
+			return match, true
+		}
+
+		if match, ok := findPII(data.fullText, r.piiDetectors); ok {
+			return match, true
+		}
+
+		if match, ok := findSecret(data.fullText, r.secretDetectors); ok {
+			return match, true
+		}
+
+		if match, ok := findSensitiveAssignment(data.fullText, r.sensitivePatterns); ok {
+			return match, true
+		}
 	}
 
 	if !data.hasDynamic {
-		return false
+		return sensitiveMatch{}, false
+	}
+
+	// Значения, уже обернутые в функцию маскирования, не проверяются.
+	operands, dynamic, redacted := r.messageOperands(pass, msg)
+	if !dynamic {
+		return sensitiveMatch{}, false
+	}
+
+	// Подпись с ключевым словом перед замаскированным значением не утечка: если сообщение
+	// удается разобрать на значения, ключевые слова проверяются только у незамаскированных.
+	var (
+		redactions []redaction
+		precise    bool
+	)
+	if redacted {
+		redactions, precise = r.findRedactions(pass, msg)
 	}
 
 	literalContext := strings.Join(data.literalParts, " ")
-	if containsPattern(literalContext, r.sensitivePatterns) {
-		return true
+	if pattern, ok := findPattern(literalContext, r.sensitivePatterns); ok && !precise {
+		return sensitiveMatch{detector: pattern.detector(), fragment: literalFragment(data.literalParts, pattern)}, true
 	}
 
-	if containsCustomPattern(literalContext, r.customPatterns) {
-		return true
+	if match, ok := findCustomPattern(literalContext, r.customPatterns); ok {
+		return match, true
+	}
+
+	// Части проверяются по отдельности, чтобы строка-кандидат не склеилась из соседних частей.
+	for _, part := range data.literalParts {
+		if match, ok := findPII(part, r.piiDetectors); ok {
+			return match, true
+		}
+
+		if match, ok := findSecret(part, r.secretDetectors); ok {
+			return match, true
+		}
+	}
+
+	if precise {
+		if len(redactions) > 0 {
+			return redactions[0].match, true
+		}
+		return sensitiveMatch{}, false
 	}
 
-	return exprContainsSensitiveIdentifier(expr, r.sensitivePatterns)
+	for _, operand := range operands {
+		if match, ok := findSensitiveIdentifier(operand, r.sensitivePatterns); ok {
+			return match, true
+		}
+	}
+
+	return sensitiveMatch{}, false
 }
 
-func exprContainsSensitiveIdentifier(expr ast.Expr, patterns []string) bool {
-	found := false
+// literalFragment возвращает литеральную часть сообщения, содержащую ключевое слово.
+// Если слово складывается только из нескольких частей, возвращается их объединение.
+func literalFragment(parts []string, pattern sensitivePattern) string {
+	for _, part := range parts {
+		if strings.Contains(normalizeForSearch(part), pattern.text) {
+			return part
+		}
+	}
+
+	return strings.Join(parts, " ")
+}
+
+func findSensitiveIdentifier(expr ast.Expr, patterns []sensitivePattern) (sensitiveMatch, bool) {
+	var (
+		match sensitiveMatch
+		found bool
+	)
 	ast.Inspect(expr, func(node ast.Node) bool {
 		if found {
 			return false
@@ -43,7 +144,8 @@ func exprContainsSensitiveIdentifier(expr ast.Expr, patterns []string) bool {
 			return true
 		}
 
-		if containsPattern(ident.Name, patterns) {
+		if pattern, ok := findPattern(ident.Name, patterns); ok {
+			match = sensitiveMatch{detector: pattern.detector(), fragment: ident.Name}
 			found = true
 			return false
 		}
@@ -51,62 +153,85 @@ func exprContainsSensitiveIdentifier(expr ast.Expr, patterns []string) bool {
 		return true
 	})
 
-	return found
+	return match, found
 }
 
-func containsSensitiveAssignment(text string, patterns []string) bool {
-	normalized := normalizeForSearch(text)
+// findSensitiveAssignment ищет ключевое слово, за которым следует ":" или "=". Поиск идет
+// по нормализованному тексту, а найденный фрагмент вырезается из исходного.
+func findSensitiveAssignment(text string, patterns []sensitivePattern) (sensitiveMatch, bool) {
+	normalized, offsets := normalizeWithOffsets(text)
 	for _, pattern := range patterns {
-		idx := strings.Index(normalized, pattern)
+		idx := strings.Index(normalized, pattern.text)
 		if idx == -1 {
 			continue
 		}
 
-		after := strings.TrimSpace(normalized[idx+len(pattern):])
+		rest := normalized[idx+len(pattern.text):]
+		after := strings.TrimLeftFunc(rest, unicode.IsSpace)
 		if after == "" {
 			continue
 		}
 
 		if strings.HasPrefix(after, ":") || strings.HasPrefix(after, "=") {
-			return true
+			end := idx + len(pattern.text) + len(rest) - len(after) + 1
+			return sensitiveMatch{detector: pattern.detector(), fragment: text[offsets[idx]:offsets[end]]}, true
 		}
 	}
 
-	return false
+	return sensitiveMatch{}, false
 }
 
-func containsPattern(text string, patterns []string) bool {
+func findPattern(text string, patterns []sensitivePattern) (sensitivePattern, bool) {
 	normalized := normalizeForSearch(text)
 	for _, pattern := range patterns {
-		if strings.Contains(normalized, pattern) {
-			return true
+		if strings.Contains(normalized, pattern.text) {
+			return pattern, true
 		}
 	}
 
-	return false
+	return sensitivePattern{}, false
 }
 
-func normalizePatterns(patterns []string) []string {
-	result := make([]string, 0, len(patterns))
-	for _, p := range patterns {
-		normalized := normalizeForSearch(p)
+// buildSensitivePatterns нормализует встроенные и пользовательские ключевые слова.
+func buildSensitivePatterns(user []string) []sensitivePattern {
+	return addSensitivePatterns(addSensitivePatterns(nil, defaultSensitivePatterns(), true), user, false)
+}
+
+// addSensitivePatterns возвращает base, дополненный нормализованными словами extra.
+// Слово, которое уже есть в base, не дублируется; base не изменяется.
+func addSensitivePatterns(base []sensitivePattern, extra []string, builtin bool) []sensitivePattern {
+	result := make([]sensitivePattern, len(base), len(base)+len(extra))
+	copy(result, base)
+
+	seen := make(map[string]struct{}, len(result))
+	for _, pattern := range result {
+		seen[pattern.text] = struct{}{}
+	}
+
+	for _, text := range extra {
+		normalized := normalizeForSearch(strings.TrimSpace(text))
 		if normalized == "" {
 			continue
 		}
-		result = append(result, normalized)
+		if _, ok := seen[normalized]; ok {
+			continue
+		}
+		seen[normalized] = struct{}{}
+		result = append(result, sensitivePattern{text: normalized, builtin: builtin})
 	}
 
 	return result
 }
 
-func containsCustomPattern(text string, patterns []*regexp.Regexp) bool {
+func findCustomPattern(text string, patterns []customPattern) (sensitiveMatch, bool) {
 	for _, pattern := range patterns {
-		if pattern.MatchString(text) {
-			return true
+		if loc := pattern.re.FindStringIndex(text); loc != nil {
+			// Пользовательский паттерн тоже описывает значение, а не ключевое слово, поэтому оно маскируется.
+			return sensitiveMatch{detector: "custom pattern: " + pattern.name, fragment: maskFragment(text[loc[0]:loc[1]])}, true
 		}
 	}
 
-	return false
+	return sensitiveMatch{}, false
 }
 
 func normalizeForSearch(text string) string {
@@ -115,6 +240,31 @@ func normalizeForSearch(text string) string {
 	return replacer.Replace(lower)
 }
 
+// normalizeWithOffsets нормализует текст так же, как normalizeForSearch, и возвращает для
+// каждого байта результата смещение руны исходного текста, из которой он получен.
+// Последний элемент offsets равен len(text), чтобы им можно было закрыть срез.
+func normalizeWithOffsets(text string) (string, []int) {
+	var (
+		normalized strings.Builder
+		offsets    = make([]int, 0, len(text)+1)
+	)
+	for i, r := range text {
+		switch r {
+		case '_', '-':
+			r = ' '
+		default:
+			r = unicode.ToLower(r)
+		}
+
+		size, _ := normalized.WriteRune(r)
+		for range size {
+			offsets = append(offsets, i)
+		}
+	}
+
+	return normalized.String(), append(offsets, len(text))
+}
+
 func defaultSensitivePatterns() []string {
 	return []string{
 		"password",
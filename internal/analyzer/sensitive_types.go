@@ -0,0 +1,224 @@
+package analyzer
+
+import (
+	"fmt"
+	"go/ast"
+	"go/types"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"golang.org/x/tools/go/analysis"
+)
+
+// formattingMethods — методы, через которые тип сам управляет своим выводом в логе:
+// fmt.Stringer, error, fmt.Formatter, slog.LogValuer, zapcore.ObjectMarshaler,
+// json.Marshaler, encoding.TextMarshaler. Поля такого типа не выводятся как есть.
+var formattingMethods = map[string]struct{}{
+	"String":           {},
+	"Error":            {},
+	"Format":           {},
+	"LogValue":         {},
+	"MarshalLogObject": {},
+	"MarshalJSON":      {},
+	"MarshalText":      {},
+}
+
+// compileSensitiveTypes разбирает полные имена типов вида "example.com/app/auth.Token".
+func compileSensitiveTypes(names []string) (map[string]struct{}, error) {
+	if len(names) == 0 {
+		return nil, nil
+	}
+
+	result := make(map[string]struct{}, len(names))
+	for _, name := range names {
+		name = strings.TrimSpace(name)
+		dot := strings.LastIndex(name, ".")
+		if dot <= 0 || dot < strings.LastIndex(name, "/") || dot == len(name)-1 {
+			return nil, fmt.Errorf("sensitive type %q: want a package path and a type name, e.g. example.com/app.Token", name)
+		}
+		result[name] = struct{}{}
+	}
+
+	return result, nil
+}
+
+// checkSensitiveTypes ищет значения, которые логгер выводит целиком (slog.Any, zap.Any,
+// хвост ...any, аргументы %v), с полями, помеченными тегами log:"-" или sensitive:"true",
+// или с типами из списка SensitiveTypes.
+func (r *runner) checkSensitiveTypes(pass *analysis.Pass, attrs []logAttr, msg logMessage, hasMsg bool) {
+	if !r.ruleEnabled(ruleSensitive) {
+		return
+	}
+
+	var values []ast.Expr
+	for _, attr := range attrs {
+		if attr.value != nil {
+			values = append(values, attr.value)
+		}
+	}
+	if hasMsg {
+		values = append(values, formattedArgs(pass, msg)...)
+	}
+
+	for _, value := range values {
+		typ := pass.TypesInfo.TypeOf(value)
+		if typ == nil {
+			continue
+		}
+
+		match, ok := r.findSensitiveType(typ, "", make(map[*types.Named]bool))
+		if !ok {
+			continue
+		}
+
+		message := fmt.Sprintf("log value of type %s may contain sensitive data", types.TypeString(typ, types.RelativeTo(pass.Pkg)))
+		r.report(pass, ruleSensitive, analysis.Diagnostic{
+			Pos:     value.Pos(),
+			End:     value.End(),
+			Message: match.describe(message),
+		})
+	}
+}
+
+// formattedArgs возвращает аргументы сообщения, которые выводятся целиком: аргументы
+// глагола %v в printf-сообщениях и все аргументы variadic-хвоста остальных сообщений.
+func formattedArgs(pass *analysis.Pass, msg logMessage) []ast.Expr {
+	if msg.kind != messagePrintf {
+		if len(msg.args) < 2 {
+			return nil
+		}
+		return msg.args
+	}
+
+	format, ok := constantString(pass, msg.args[0])
+	if !ok {
+		return nil
+	}
+
+	var (
+		args []ast.Expr
+		next = 1
+	)
+	for _, segment := range splitFormat(format) {
+		if !segment.verb {
+			continue
+		}
+
+		// Флаги и ширина могут содержать * и явный индекс [n], которые сдвигают аргумент.
+		index := next
+		text := segment.text
+		for i := 1; i < len(text)-1; i++ {
+			switch text[i] {
+			case '*':
+				index++
+			case '[':
+				if closing := strings.IndexByte(text[i:], ']'); closing > 0 {
+					if n, err := strconv.Atoi(text[i+1 : i+closing]); err == nil {
+						index = n
+					}
+					i += closing
+				}
+			}
+		}
+
+		if text[len(text)-1] == 'v' && index < len(msg.args) {
+			args = append(args, msg.args[index])
+		}
+		next = index + 1
+	}
+
+	return args
+}
+
+// findSensitiveType обходит тип значения и возвращает путь к первому чувствительному
+// полю или вложенному значению типа из списка: "User.Credentials.Password".
+func (r *runner) findSensitiveType(typ types.Type, path string, seen map[*types.Named]bool) (sensitiveMatch, bool) {
+	if named, ok := types.Unalias(typ).(*types.Named); ok {
+		named = named.Origin()
+		if seen[named] {
+			return sensitiveMatch{}, false
+		}
+		seen[named] = true
+
+		if path == "" {
+			path = named.Obj().Name()
+		}
+
+		if pkg := named.Obj().Pkg(); pkg != nil {
+			name := pkg.Path() + "." + named.Obj().Name()
+			if _, ok := r.sensitiveTypes[name]; ok {
+				return sensitiveMatch{detector: "sensitive type: " + name, fragment: path}, true
+			}
+		}
+	}
+
+	if formatsItself(typ) {
+		return sensitiveMatch{}, false
+	}
+
+	switch t := typ.Underlying().(type) {
+	case *types.Pointer:
+		return r.findSensitiveType(t.Elem(), path, seen)
+	case *types.Slice:
+		return r.findSensitiveType(t.Elem(), elemPath(path), seen)
+	case *types.Array:
+		return r.findSensitiveType(t.Elem(), elemPath(path), seen)
+	case *types.Map:
+		return r.findSensitiveType(t.Elem(), elemPath(path), seen)
+	case *types.Struct:
+		for i := 0; i < t.NumFields(); i++ {
+			field := t.Field(i)
+			fieldPath := field.Name()
+			if path != "" {
+				fieldPath = path + "." + fieldPath
+			}
+
+			if tag, ok := sensitiveTag(t.Tag(i)); ok {
+				return sensitiveMatch{detector: "struct tag " + tag, fragment: fieldPath}, true
+			}
+
+			if match, ok := r.findSensitiveType(field.Type(), fieldPath, seen); ok {
+				return match, true
+			}
+		}
+	}
+
+	return sensitiveMatch{}, false
+}
+
+func elemPath(path string) string {
+	if path == "" {
+		return ""
+	}
+
+	return path + "[]"
+}
+
+// sensitiveTag возвращает тег, которым помечено чувствительное поле: log:"-" или sensitive:"true".
+func sensitiveTag(tag string) (string, bool) {
+	structTag := reflect.StructTag(tag)
+	if structTag.Get("log") == "-" {
+		return `log:"-"`, true
+	}
+
+	if value, ok := structTag.Lookup("sensitive"); ok {
+		if sensitive, err := strconv.ParseBool(value); err == nil && sensitive {
+			return fmt.Sprintf("sensitive:%q", value), true
+		}
+	}
+
+	return "", false
+}
+
+// formatsItself сообщает, есть ли у типа метод, которым он сам задает свой вывод.
+func formatsItself(typ types.Type) bool {
+	methods := types.NewMethodSet(typ)
+	for i := 0; i < methods.Len(); i++ {
+		if _, ok := formattingMethods[methods.At(i).Obj().Name()]; ok {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,77 @@
+package analyzer
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Уровни важности правил для Options.Severity.
+const (
+	SeverityError   = "error"
+	SeverityWarning = "warning"
+	SeverityInfo    = "info"
+	// SeverityOff отключает правило так же, как DisabledRules.
+	SeverityOff = "off"
+)
+
+var severities = map[string]struct{}{
+	SeverityError:   {},
+	SeverityWarning: {},
+	SeverityInfo:    {},
+	SeverityOff:     {},
+}
+
+// normalizeSeverities проверяет карту правило -> уровень. Правила без уровня
+// считаются ошибками (SeverityError).
+func normalizeSeverities(levels map[string]string) (map[string]string, error) {
+	if len(levels) == 0 {
+		return nil, nil
+	}
+
+	rules := make([]string, 0, len(levels))
+	for rule := range levels {
+		rules = append(rules, rule)
+	}
+	sort.Strings(rules)
+
+	result := make(map[string]string, len(levels))
+	for _, rule := range rules {
+		name := strings.ToLower(strings.TrimSpace(rule))
+		if !isKnownRule(name) {
+			return nil, fmt.Errorf("severity: unknown rule %q", rule)
+		}
+
+		level := strings.ToLower(strings.TrimSpace(levels[rule]))
+		if _, ok := severities[level]; !ok {
+			return nil, fmt.Errorf("severity: unknown level %q for rule %q", levels[rule], rule)
+		}
+		result[name] = level
+	}
+
+	return result, nil
+}
+
+func (r *runner) severity(rule string) string {
+	if level, ok := r.severities[rule]; ok {
+		return level
+	}
+
+	return SeverityError
+}
+
+// Category возвращает категорию диагностики: имя правила и уровень через двоеточие,
+// например "sensitive:error".
+func Category(rule, severity string) string {
+	return rule + ":" + severity
+}
+
+// SplitCategory разбирает категорию диагностики на правило и уровень важности.
+func SplitCategory(category string) (rule, severity string) {
+	rule, severity, ok := strings.Cut(category, ":")
+	if !ok {
+		return category, SeverityError
+	}
+
+	return rule, severity
+}
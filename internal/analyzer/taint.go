@@ -0,0 +1,695 @@
+package analyzer
+
+import (
+	"fmt"
+	"go/ast"
+	"go/constant"
+	"go/token"
+	"go/types"
+	"path"
+	"sort"
+	"strings"
+
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/analysis/passes/buildssa"
+	"golang.org/x/tools/go/ssa"
+)
+
+// Виды источников секретов для TaintSource.Kind.
+const (
+	// TaintCall — секретом считается результат вызова: os.Getenv("DB_PASSWORD").
+	TaintCall = "call"
+	// TaintField — секретом считается чтение поля структуры: cfg.DSN.
+	TaintField = "field"
+	// TaintArgument — секретом считается значение, переданное аргументом: DSN в sql.Open.
+	TaintArgument = "argument"
+)
+
+// TaintSource описывает источник секретов для правила taint.
+type TaintSource struct {
+	// Kind — TaintCall, TaintField или TaintArgument.
+	Kind string
+	// Package — путь импорта пакета функции или типа.
+	Package string
+	// Type — имя типа метода или структуры поля; пустое — функция пакетного уровня.
+	Type string
+	// Name — имя функции, метода или поля.
+	Name string
+	// Arg — индекс аргумента (без получателя) для TaintArgument.
+	Arg int
+	// Keyed означает, что первый аргумент вызова — имя значения (заголовка, cookie,
+	// переменной окружения). Если имя задано константой, источником считаются только
+	// имена, похожие на чувствительные: "Authorization", "DB_PASSWORD".
+	Keyed bool
+}
+
+// taintSources — скомпилированные источники, по ключу реестра логгеров.
+type taintSources struct {
+	calls     map[loggerKey]TaintSource
+	fields    map[loggerKey]TaintSource
+	arguments map[loggerKey]TaintSource
+}
+
+func defaultTaintSources() []TaintSource {
+	return []TaintSource{
+		{Kind: TaintCall, Package: "net/http", Type: "Header", Name: "Get", Keyed: true},
+		{Kind: TaintCall, Package: "net/http", Type: "Header", Name: "Values", Keyed: true},
+		{Kind: TaintCall, Package: "net/http", Type: "Request", Name: "Cookie", Keyed: true},
+		{Kind: TaintCall, Package: "net/http", Type: "Request", Name: "Cookies"},
+		{Kind: TaintCall, Package: "net/http", Type: "Request", Name: "BasicAuth"},
+		{Kind: TaintCall, Package: "os", Name: "Getenv", Keyed: true},
+		{Kind: TaintCall, Package: "os", Name: "LookupEnv", Keyed: true},
+		{Kind: TaintCall, Package: "os", Name: "Environ"},
+		{Kind: TaintCall, Package: "syscall", Name: "Getenv", Keyed: true},
+		{Kind: TaintField, Package: "net/url", Type: "URL", Name: "User"},
+		{Kind: TaintArgument, Package: "database/sql", Name: "Open", Arg: 1},
+	}
+}
+
+// sensitiveKeys дополняет ключевые слова чувствительных данных для имен
+// заголовков, cookie и переменных окружения.
+var sensitiveKeys = []string{"authorization", "cookie", "session", "dsn", "database url"}
+
+func compileTaintSources(user []TaintSource) (taintSources, error) {
+	sources := taintSources{
+		calls:     make(map[loggerKey]TaintSource),
+		fields:    make(map[loggerKey]TaintSource),
+		arguments: make(map[loggerKey]TaintSource),
+	}
+
+	add := func(source TaintSource) error {
+		if source.Package == "" || source.Name == "" {
+			return fmt.Errorf("package and name are required")
+		}
+
+		key := loggerKey{pkg: source.Package, typ: source.Type, method: source.Name}
+		switch source.Kind {
+		case TaintCall:
+			sources.calls[key] = source
+		case TaintField:
+			if source.Type == "" {
+				return fmt.Errorf("field source %s.%s requires a type", source.Package, source.Name)
+			}
+			sources.fields[key] = source
+		case TaintArgument:
+			if source.Arg < 0 {
+				return fmt.Errorf("argument index must not be negative")
+			}
+			sources.arguments[key] = source
+		default:
+			return fmt.Errorf("unknown kind %q", source.Kind)
+		}
+
+		return nil
+	}
+
+	for _, source := range defaultTaintSources() {
+		if err := add(source); err != nil {
+			return taintSources{}, err
+		}
+	}
+
+	for i, source := range user {
+		if err := add(source); err != nil {
+			return taintSources{}, fmt.Errorf("taint source %d: %w", i, err)
+		}
+	}
+
+	return sources, nil
+}
+
+// taintFact — сводка функции для межпакетного анализа. Индексы параметров считаются
+// вместе с получателем метода, как аргументы ssa.CallCommon.
+type taintFact struct {
+	// Source — источник, если функция возвращает секрет независимо от аргументов.
+	Source string
+	// ToResult — параметры, значение которых попадает в результат.
+	ToResult []int
+	// ToLog — параметры, значение которых попадает в аргументы логгера.
+	ToLog []int
+}
+
+func (*taintFact) AFact() {}
+
+func (f *taintFact) String() string {
+	return fmt.Sprintf("taint(source=%q, result=%v, log=%v)", f.Source, f.ToResult, f.ToLog)
+}
+
+func (f *taintFact) empty() bool {
+	return f.Source == "" && len(f.ToResult) == 0 && len(f.ToLog) == 0
+}
+
+func (f *taintFact) equal(other *taintFact) bool {
+	return f.Source == other.Source && intsEqual(f.ToResult, other.ToResult) && intsEqual(f.ToLog, other.ToLog)
+}
+
+func intsEqual(a, b []int) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+
+	return true
+}
+
+// taintLabels — множество меток значения: taintSecret — значение содержит секрет,
+// остальные биты — значение зависит от параметра функции (см. paramLabel).
+type taintLabels uint64
+
+const taintSecret taintLabels = 1
+
+// paramLabel возвращает метку параметра i; параметры после 62-го не отслеживаются.
+func paramLabel(i int) taintLabels {
+	if i >= 63 {
+		return 0
+	}
+
+	return 1 << (i + 1)
+}
+
+func (l taintLabels) params() []int {
+	var params []int
+	for i := 0; i < 63; i++ {
+		if l&paramLabel(i) != 0 {
+			params = append(params, i)
+		}
+	}
+
+	return params
+}
+
+// taintState — метки значений одной функции. origins хранит описание источника
+// для значений с taintSecret.
+type taintState struct {
+	labels  map[ssa.Value]taintLabels
+	origins map[ssa.Value]string
+}
+
+func newTaintState() *taintState {
+	return &taintState{labels: make(map[ssa.Value]taintLabels), origins: make(map[ssa.Value]string)}
+}
+
+func (s *taintState) add(v ssa.Value, labels taintLabels, origin string) bool {
+	if v == nil || labels == 0 || s.labels[v]|labels == s.labels[v] {
+		return false
+	}
+
+	s.labels[v] |= labels
+	if labels&taintSecret != 0 && s.origins[v] == "" {
+		s.origins[v] = origin
+	}
+
+	return true
+}
+
+func (s *taintState) merge(dst, src ssa.Value) bool {
+	return s.add(dst, s.labels[src], s.origins[src])
+}
+
+// taintLeak — вызов логгера, в аргументы которого попадает секрет.
+type taintLeak struct {
+	pos    token.Pos
+	origin string
+}
+
+// taintAnalysis строит сводки функций пакета и находит утечки секретов в логи.
+type taintAnalysis struct {
+	r     *runner
+	pass  *analysis.Pass
+	local map[*types.Func]*taintFact
+	// propagating — пакеты, функции которых переносят секрет из аргументов в результат.
+	propagating map[string]bool
+	// captured — метки переменных, захваченных замыканиями (ssa.FreeVar).
+	captured *taintState
+}
+
+// propagatingPackages — пакеты, функции которых возвращают производное от аргументов:
+// форматирование, преобразование строк, конструкторы атрибутов и дочерние логгеры.
+var propagatingPackages = []string{
+	"fmt", "strings", "strconv", "bytes", "errors", "path", "path/filepath", "net/url",
+	"encoding/base64", "encoding/hex", "encoding/json",
+	"log/slog", "go.uber.org/zap", "github.com/sirupsen/logrus", zerologPkgPath,
+}
+
+// taintLeaks выполняет taint-анализ пакета: экспортирует сводки функций фактами
+// и возвращает утечки, сгруппированные по файлам.
+func (r *runner) taintLeaks(pass *analysis.Pass) map[*token.File][]taintLeak {
+	ssaInfo, ok := pass.ResultOf[buildssa.Analyzer].(*buildssa.SSA)
+	if !ok {
+		return nil
+	}
+
+	t := &taintAnalysis{
+		r:           r,
+		pass:        pass,
+		local:       make(map[*types.Func]*taintFact),
+		propagating: make(map[string]bool),
+		captured:    newTaintState(),
+	}
+	for _, pkg := range propagatingPackages {
+		t.propagating[pkg] = true
+	}
+	for key := range r.loggers {
+		t.propagating[key.pkg] = true
+	}
+
+	// Сводки функций пакета зависят друг от друга, поэтому считаются до неподвижной
+	// точки. Метки и сводки только растут, поэтому цикл завершается.
+	for changed := true; changed; {
+		changed = false
+		for _, fn := range ssaInfo.SrcFuncs {
+			obj, ok := fn.Object().(*types.Func)
+			if !ok {
+				continue
+			}
+
+			fact, _ := t.summarize(fn, t.analyze(fn))
+			if old, ok := t.local[obj]; !ok && !fact.empty() || ok && !old.equal(fact) {
+				t.local[obj] = fact
+				changed = true
+			}
+		}
+	}
+
+	// Другие пакеты вызывают только экспортированные функции и методы.
+	for obj, fact := range t.local {
+		if obj.Exported() && !fact.empty() {
+			pass.ExportObjectFact(obj, fact)
+		}
+	}
+
+	// Замыкание анализируется после объемлющей функции: только тогда известны метки
+	// захваченных им переменных.
+	var funcs []*ssa.Function
+	var walk func(fn *ssa.Function)
+	walk = func(fn *ssa.Function) {
+		funcs = append(funcs, fn)
+		for _, anon := range fn.AnonFuncs {
+			walk(anon)
+		}
+	}
+	for _, fn := range ssaInfo.SrcFuncs {
+		if fn.Parent() == nil {
+			walk(fn)
+		}
+	}
+
+	leaks := make(map[*token.File][]taintLeak)
+	for _, fn := range funcs {
+		_, fnLeaks := t.summarize(fn, t.analyze(fn))
+		for _, leak := range fnLeaks {
+			file := pass.Fset.File(leak.pos)
+			leaks[file] = append(leaks[file], leak)
+		}
+	}
+
+	return leaks
+}
+
+// analyze вычисляет метки значений функции. Метки только растут, поэтому обход
+// инструкций до отсутствия изменений завершается.
+func (t *taintAnalysis) analyze(fn *ssa.Function) *taintState {
+	state := newTaintState()
+	for i, param := range fn.Params {
+		state.add(param, paramLabel(i), "")
+	}
+	for _, freeVar := range fn.FreeVars {
+		state.add(freeVar, t.captured.labels[freeVar], t.captured.origins[freeVar])
+	}
+
+	for changed := true; changed; {
+		changed = false
+		for _, block := range fn.Blocks {
+			for _, instr := range block.Instrs {
+				if t.step(state, instr) {
+					changed = true
+				}
+			}
+		}
+	}
+
+	return state
+}
+
+func (t *taintAnalysis) step(s *taintState, instr ssa.Instruction) bool {
+	switch i := instr.(type) {
+	case *ssa.BinOp:
+		changed := s.merge(i, i.X)
+		return s.merge(i, i.Y) || changed
+	case *ssa.UnOp:
+		return s.merge(i, i.X)
+	case *ssa.Convert:
+		return s.merge(i, i.X)
+	case *ssa.MultiConvert:
+		return s.merge(i, i.X)
+	case *ssa.ChangeType:
+		return s.merge(i, i.X)
+	case *ssa.MakeInterface:
+		return s.merge(i, i.X)
+	case *ssa.ChangeInterface:
+		return s.merge(i, i.X)
+	case *ssa.TypeAssert:
+		return s.merge(i, i.X)
+	case *ssa.SliceToArrayPointer:
+		return s.merge(i, i.X)
+	case *ssa.Extract:
+		return s.merge(i, i.Tuple)
+	case *ssa.Index:
+		return s.merge(i, i.X)
+	case *ssa.IndexAddr:
+		return s.merge(i, i.X)
+	case *ssa.Lookup:
+		return s.merge(i, i.X)
+	case *ssa.Slice:
+		return s.merge(i, i.X)
+	case *ssa.Range:
+		return s.merge(i, i.X)
+	case *ssa.Next:
+		return s.merge(i, i.Iter)
+	case *ssa.Phi:
+		changed := false
+		for _, edge := range i.Edges {
+			changed = s.merge(i, edge) || changed
+		}
+		return changed
+	case *ssa.Field:
+		changed := s.merge(i, i.X)
+		return t.fieldSource(s, i, i.X.Type(), i.Field) || changed
+	case *ssa.FieldAddr:
+		changed := s.merge(i, i.X)
+		return t.fieldSource(s, i, i.X.Type(), i.Field) || changed
+	case *ssa.Store:
+		// Запись секрета в поле или элемент помечает и всю структуру, срез или массив.
+		changed := false
+		for addr := i.Addr; addr != nil; {
+			changed = s.merge(addr, i.Val) || changed
+			switch a := addr.(type) {
+			case *ssa.FieldAddr:
+				addr = a.X
+			case *ssa.IndexAddr:
+				addr = a.X
+			default:
+				addr = nil
+			}
+		}
+		return changed
+	case *ssa.MapUpdate:
+		changed := s.merge(i.Map, i.Key)
+		return s.merge(i.Map, i.Value) || changed
+	case *ssa.Send:
+		return s.merge(i.Chan, i.X)
+	case *ssa.MakeClosure:
+		t.capture(s, i)
+	case *ssa.Call:
+		return t.call(s, i, i.Common())
+	}
+
+	return false
+}
+
+// capture передает замыканию секреты, которые оно захватывает. Зависимость от параметров
+// объемлющей функции не передается: в замыкании эти биты означали бы его собственные
+// параметры, поэтому вызов логгера в замыкании не попадает в сводку объемлющей функции.
+func (t *taintAnalysis) capture(s *taintState, closure *ssa.MakeClosure) {
+	fn, ok := closure.Fn.(*ssa.Function)
+	if !ok {
+		return
+	}
+
+	for i, binding := range closure.Bindings {
+		if i < len(fn.FreeVars) {
+			t.captured.add(fn.FreeVars[i], s.labels[binding]&taintSecret, s.origins[binding])
+		}
+	}
+}
+
+func (t *taintAnalysis) fieldSource(s *taintState, v ssa.Value, typ types.Type, index int) bool {
+	if ptr, ok := typ.Underlying().(*types.Pointer); ok {
+		typ = ptr.Elem()
+	}
+
+	named := namedType(typ)
+	if named == nil || named.Obj().Pkg() == nil {
+		return false
+	}
+
+	st, ok := named.Underlying().(*types.Struct)
+	if !ok || index >= st.NumFields() {
+		return false
+	}
+
+	key := loggerKey{pkg: named.Obj().Pkg().Path(), typ: named.Obj().Name(), method: st.Field(index).Name()}
+	if _, ok := t.r.taint.fields[key]; !ok {
+		return false
+	}
+
+	return s.add(v, taintSecret, sourceName(key))
+}
+
+func (t *taintAnalysis) call(s *taintState, v ssa.Value, common *ssa.CallCommon) bool {
+	fn := calleeFunc(common)
+	if fn == nil || fn.Pkg() == nil {
+		return false
+	}
+
+	args := callArgs(common)
+	changed := false
+	// Для функции-источника решает keyMatches, а не ее сводка: os.Getenv("HOME")
+	// не секрет, хотя внутри вызывает syscall.Getenv с динамическим именем.
+	isSource := false
+	if key, ok := calleeKey(fn); ok {
+		if source, ok := t.r.taint.calls[key]; ok {
+			isSource = true
+			if t.keyMatches(source, fn, args) {
+				changed = s.add(v, taintSecret, sourceName(key)) || changed
+			}
+		}
+
+		if source, ok := t.r.taint.arguments[key]; ok {
+			if index := source.Arg + recvOffset(fn); index < len(args) {
+				origin := fmt.Sprintf("argument %d of %s", source.Arg, sourceName(key))
+				changed = s.add(args[index], taintSecret, origin) || changed
+			}
+		}
+	}
+
+	if fact, ok := t.summary(fn); ok {
+		if fact.Source != "" && !isSource {
+			changed = s.add(v, taintSecret, fact.Source) || changed
+		}
+		for _, index := range fact.ToResult {
+			if index < len(args) {
+				changed = s.merge(v, args[index]) || changed
+			}
+		}
+	}
+
+	if t.propagating[fn.Pkg().Path()] {
+		for _, arg := range args {
+			changed = s.merge(v, arg) || changed
+		}
+	}
+
+	return changed
+}
+
+// keyMatches проверяет имя значения для источников с Keyed: динамическое имя
+// считается подозрительным, константное — только если похоже на чувствительное.
+func (t *taintAnalysis) keyMatches(source TaintSource, fn *types.Func, args []ssa.Value) bool {
+	if !source.Keyed {
+		return true
+	}
+
+	index := recvOffset(fn)
+	if index >= len(args) {
+		return true
+	}
+
+	key, ok := args[index].(*ssa.Const)
+	if !ok || key.Value == nil || key.Value.Kind() != constant.String {
+		return true
+	}
+
+	name := constant.StringVal(key.Value)
+	if _, ok := findPattern(name, t.r.sensitivePatterns); ok {
+		return true
+	}
+
+	normalized := normalizeForSearch(name)
+	for _, keyword := range sensitiveKeys {
+		if strings.Contains(normalized, keyword) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (t *taintAnalysis) summary(fn *types.Func) (*taintFact, bool) {
+	if fact, ok := t.local[fn]; ok {
+		return fact, true
+	}
+
+	var fact taintFact
+	if fn.Pkg() != t.pass.Pkg && t.pass.ImportObjectFact(fn, &fact) {
+		return &fact, true
+	}
+
+	return nil, false
+}
+
+// summarize строит сводку функции по меткам ее значений и находит вызовы логгеров,
+// в аргументы которых попадает секрет.
+func (t *taintAnalysis) summarize(fn *ssa.Function, s *taintState) (*taintFact, []taintLeak) {
+	var (
+		fact     taintFact
+		toResult taintLabels
+		toLog    taintLabels
+		leaks    []taintLeak
+	)
+
+	for _, block := range fn.Blocks {
+		for _, instr := range block.Instrs {
+			switch i := instr.(type) {
+			case *ssa.Return:
+				for _, result := range i.Results {
+					toResult |= s.labels[result]
+					if s.labels[result]&taintSecret != 0 && fact.Source == "" {
+						fact.Source = s.origins[result]
+					}
+				}
+			case ssa.CallInstruction:
+				args := callArgs(i.Common())
+				leaked := false
+				for _, index := range t.logArgs(i.Common(), len(args)) {
+					labels := s.labels[args[index]]
+					toLog |= labels
+					if labels&taintSecret != 0 && !leaked {
+						leaks = append(leaks, taintLeak{pos: i.Pos(), origin: s.origins[args[index]]})
+						leaked = true
+					}
+				}
+			}
+		}
+	}
+
+	fact.ToResult = toResult.params()
+	fact.ToLog = toLog.params()
+	return &fact, leaks
+}
+
+// logArgs возвращает индексы аргументов вызова (с получателем), которые попадают в запись
+// лога: сообщение и все, что после него, у логгеров и оберток, получатель у методов
+// логгеров (дочерний логгер из With или событие zerolog) и параметры из сводки taintFact.
+func (t *taintAnalysis) logArgs(common *ssa.CallCommon, count int) []int {
+	fn := calleeFunc(common)
+	if fn == nil {
+		return nil
+	}
+
+	offset := recvOffset(fn)
+	indexes := make(map[int]bool)
+	addFrom := func(from int) {
+		for i := from; i < count; i++ {
+			indexes[i] = true
+		}
+	}
+
+	if key, ok := calleeKey(fn); ok {
+		if method, ok := t.r.loggers[key]; ok {
+			if offset > 0 {
+				indexes[0] = true
+			}
+			addFrom(method.msgIndex + offset)
+		}
+	}
+
+	var wrapper loggerWrapperFact
+	if t.pass.ImportObjectFact(fn, &wrapper) {
+		addFrom(wrapper.MessageIndex + offset)
+	}
+
+	if fact, ok := t.summary(fn); ok {
+		for _, index := range fact.ToLog {
+			if index < count {
+				indexes[index] = true
+			}
+		}
+	}
+
+	result := make([]int, 0, len(indexes))
+	for index := range indexes {
+		result = append(result, index)
+	}
+	sort.Ints(result)
+
+	return result
+}
+
+// calleeFunc возвращает вызываемую функцию или метод интерфейса.
+func calleeFunc(common *ssa.CallCommon) *types.Func {
+	if common.IsInvoke() {
+		return common.Method
+	}
+
+	if callee := common.StaticCallee(); callee != nil {
+		if obj, ok := callee.Object().(*types.Func); ok {
+			return obj.Origin()
+		}
+	}
+
+	return nil
+}
+
+// callArgs возвращает аргументы вызова вместе с получателем, в том числе для вызова
+// метода интерфейса, где получатель хранится отдельно.
+func callArgs(common *ssa.CallCommon) []ssa.Value {
+	if common.IsInvoke() {
+		return append([]ssa.Value{common.Value}, common.Args...)
+	}
+
+	return common.Args
+}
+
+func recvOffset(fn *types.Func) int {
+	if sig, ok := fn.Type().(*types.Signature); ok && sig.Recv() != nil {
+		return 1
+	}
+
+	return 0
+}
+
+// sourceName возвращает короткое имя источника: os.Getenv, http.Header.Get.
+func sourceName(key loggerKey) string {
+	name := path.Base(key.pkg)
+	if key.typ != "" {
+		name += "." + key.typ
+	}
+
+	return name + "." + key.method
+}
+
+// reportTaint сообщает об утечках секретов в файле file.
+func (r *runner) reportTaint(pass *analysis.Pass, file *ast.File, leaks map[*token.File][]taintLeak) {
+	for _, leak := range leaks[pass.Fset.File(file.Pos())] {
+		diag := analysis.Diagnostic{
+			Pos:     leak.pos,
+			Message: fmt.Sprintf("log call may contain a secret from %s", leak.origin),
+		}
+
+		// Позиция ssa.Call — открывающая скобка; диагностика охватывает весь вызов.
+		ast.Inspect(file, func(node ast.Node) bool {
+			if call, ok := node.(*ast.CallExpr); ok && call.Lparen == leak.pos {
+				diag.Pos, diag.End = call.Pos(), call.End()
+				return false
+			}
+			return diag.End == token.NoPos
+		})
+
+		r.report(pass, ruleTaint, diag)
+	}
+}
@@ -0,0 +1,144 @@
+package analyzer
+
+import (
+	"fmt"
+	"go/ast"
+	"go/types"
+
+	"golang.org/x/tools/go/analysis"
+)
+
+// loggerWrapperFact помечает функцию, параметр которой без изменений передается
+// в сообщение распознанного логгера, например:
+//
+//	func (s *Service) logInfo(msg string, args ...any) { s.log.Info(msg, args...) }
+//
+// Вызовы таких функций проверяются так же, как вызовы самого логгера.
+type loggerWrapperFact struct {
+	MessageIndex int
+	Kind         messageKind
+}
+
+func (*loggerWrapperFact) AFact() {}
+
+func (f *loggerWrapperFact) String() string {
+	return fmt.Sprintf("loggerWrapper(%d, %s)", f.MessageIndex, f.Kind)
+}
+
+func (k messageKind) String() string {
+	switch k {
+	case messageLine:
+		return KindPrintln
+	case messagePrintf:
+		return KindPrintf
+	case messageKeyValue:
+		return KindKeyValue
+	default:
+		return KindPlain
+	}
+}
+
+// detectWrappers находит обертки над логгерами в текущем пакете и экспортирует
+// о них факты. Обертки могут вызывать друг друга в любом порядке объявления,
+// поэтому поиск повторяется, пока находятся новые обертки.
+func (r *runner) detectWrappers(pass *analysis.Pass) {
+	var decls []*ast.FuncDecl
+	for _, file := range pass.Files {
+		for _, decl := range file.Decls {
+			if fn, ok := decl.(*ast.FuncDecl); ok && fn.Body != nil {
+				decls = append(decls, fn)
+			}
+		}
+	}
+
+	for changed := true; changed; {
+		changed = false
+		for _, decl := range decls {
+			fn, ok := pass.TypesInfo.Defs[decl.Name].(*types.Func)
+			if !ok || pass.ImportObjectFact(fn, new(loggerWrapperFact)) {
+				continue
+			}
+
+			if fact, ok := r.wrapperFact(pass, fn, decl.Body); ok {
+				pass.ExportObjectFact(fn, fact)
+				changed = true
+			}
+		}
+	}
+}
+
+// wrapperFact ищет в теле функции вызов логгера, в сообщение которого
+// напрямую передается параметр функции.
+func (r *runner) wrapperFact(pass *analysis.Pass, fn *types.Func, body *ast.BlockStmt) (*loggerWrapperFact, bool) {
+	sig, ok := fn.Type().(*types.Signature)
+	if !ok || sig.Params().Len() == 0 {
+		return nil, false
+	}
+
+	var fact *loggerWrapperFact
+	ast.Inspect(body, func(node ast.Node) bool {
+		if fact != nil {
+			return false
+		}
+
+		switch n := node.(type) {
+		case *ast.FuncLit:
+			// Тело замыкания может так и не выполниться.
+			return false
+		case *ast.CallExpr:
+			msgIndex, kind, ok := r.resolveMessageIndex(pass, n)
+			if !ok || msgIndex < 0 || len(n.Args) <= msgIndex {
+				return true
+			}
+
+			paramIndex, ok := paramIndexOf(pass, sig, n.Args[msgIndex])
+			if !ok {
+				return true
+			}
+
+			param := sig.Params().At(paramIndex)
+			forwardsTail := n.Ellipsis.IsValid() && msgIndex == len(n.Args)-1
+			switch {
+			case forwardsTail && sig.Variadic() && paramIndex == sig.Params().Len()-1:
+				// func logAll(args ...any) { logrus.Info(args...) }
+				fact = &loggerWrapperFact{MessageIndex: paramIndex, Kind: kind}
+			case types.AssignableTo(param.Type(), types.Typ[types.String]):
+				fact = &loggerWrapperFact{MessageIndex: paramIndex, Kind: kind}
+			}
+		}
+
+		return true
+	})
+
+	return fact, fact != nil
+}
+
+// paramIndexOf возвращает индекс параметра функции, на который ссылается выражение.
+func paramIndexOf(pass *analysis.Pass, sig *types.Signature, expr ast.Expr) (int, bool) {
+	ident, ok := ast.Unparen(expr).(*ast.Ident)
+	if !ok {
+		return -1, false
+	}
+
+	obj := pass.TypesInfo.Uses[ident]
+	if obj == nil {
+		return -1, false
+	}
+
+	for i := 0; i < sig.Params().Len(); i++ {
+		if sig.Params().At(i) == obj {
+			return i, true
+		}
+	}
+
+	return -1, false
+}
+
+func (r *runner) wrapperMessageIndex(pass *analysis.Pass, fn *types.Func) (int, messageKind, bool) {
+	var fact loggerWrapperFact
+	if !pass.ImportObjectFact(fn, &fact) {
+		return -1, messagePlain, false
+	}
+
+	return fact.MessageIndex, fact.Kind, true
+}
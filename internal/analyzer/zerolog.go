@@ -0,0 +1,55 @@
+package analyzer
+
+import (
+	"go/ast"
+	"go/types"
+
+	"golang.org/x/tools/go/analysis"
+)
+
+const zerologPkgPath = "github.com/rs/zerolog"
+
+// zerologEventFields возвращает поля, которые добавлены в цепочку *zerolog.Event
+// перед терминальным Msg/Msgf/Send, например log.Info().Str("k", v).Msg("text").
+func zerologEventFields(pass *analysis.Pass, call *ast.CallExpr) []logAttr {
+	sel, ok := call.Fun.(*ast.SelectorExpr)
+	if !ok || !isZerologEvent(pass.TypesInfo.TypeOf(sel.X)) {
+		return nil
+	}
+
+	switch sel.Sel.Name {
+	case "Msg", "Msgf", "Send":
+	default:
+		return nil
+	}
+
+	var fields []logAttr
+	recv := sel.X
+	for {
+		recvCall, ok := ast.Unparen(recv).(*ast.CallExpr)
+		if !ok {
+			break
+		}
+
+		recvSel, ok := recvCall.Fun.(*ast.SelectorExpr)
+		if !ok || !isZerologEvent(pass.TypesInfo.TypeOf(recvSel.X)) {
+			break
+		}
+
+		if attr, ok := keyedAttr(pass, recvCall); ok {
+			fields = append(fields, attr)
+		}
+		recv = recvSel.X
+	}
+
+	return fields
+}
+
+func isZerologEvent(typ types.Type) bool {
+	named := namedType(typ)
+	if named == nil || named.Obj() == nil || named.Obj().Pkg() == nil {
+		return false
+	}
+
+	return named.Obj().Pkg().Path() == zerologPkgPath && named.Obj().Name() == "Event"
+}
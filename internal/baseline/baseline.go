@@ -0,0 +1,155 @@
+// Package baseline хранит известные находки loglint, принятые при внедрении линтера
+// в существующий код. Записи не содержат номеров строк, поэтому переживают правки файла.
+package baseline
+
+import (
+	"encoding/json"
+	"fmt"
+	"go/ast"
+	"go/token"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// DefaultPath — путь к файлу baseline по умолчанию.
+const DefaultPath = ".loglint-baseline.json"
+
+// Entry — одна известная находка.
+type Entry struct {
+	Rule string `json:"rule"`
+	// File — путь к файлу относительно каталога baseline, через "/".
+	File string `json:"file"`
+	// Func — объемлющая функция ("Func" или "Type.Method"); пусто на уровне пакета.
+	Func    string `json:"func,omitempty"`
+	Message string `json:"message"`
+}
+
+// Baseline — набор известных находок.
+type Baseline struct {
+	// Root — абсолютный путь каталога, относительно которого записаны пути файлов.
+	Root    string  `json:"-"`
+	Entries []Entry `json:"entries"`
+}
+
+// New создает пустой baseline, пути в котором считаются от каталога файла path.
+func New(path string) (*Baseline, error) {
+	root, err := filepath.Abs(filepath.Dir(path))
+	if err != nil {
+		return nil, err
+	}
+
+	return &Baseline{Root: root}, nil
+}
+
+// Load читает baseline из path.
+func Load(path string) (*Baseline, error) {
+	b, err := New(path)
+	if err != nil {
+		return nil, err
+	}
+
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal(raw, b); err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+
+	return b, nil
+}
+
+// Write сохраняет baseline в path. Записи сортируются, чтобы файл давал стабильный diff.
+func (b *Baseline) Write(path string) error {
+	sort.Slice(b.Entries, func(i, j int) bool {
+		x, y := b.Entries[i], b.Entries[j]
+		if x.File != y.File {
+			return x.File < y.File
+		}
+		if x.Func != y.Func {
+			return x.Func < y.Func
+		}
+		if x.Rule != y.Rule {
+			return x.Rule < y.Rule
+		}
+		return x.Message < y.Message
+	})
+
+	if b.Entries == nil {
+		b.Entries = []Entry{}
+	}
+
+	raw, err := json.MarshalIndent(b, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, append(raw, '\n'), 0o644)
+}
+
+// NewEntry строит запись для диагностики правила rule в файле filename.
+func (b *Baseline) NewEntry(rule, filename, fn, message string) Entry {
+	return Entry{
+		Rule:    rule,
+		File:    b.RelPath(filename),
+		Func:    fn,
+		Message: NormalizeMessage(message),
+	}
+}
+
+// RelPath возвращает путь файла относительно Root. Файлы вне Root остаются
+// с абсолютным путем.
+func (b *Baseline) RelPath(filename string) string {
+	rel, err := filepath.Rel(b.Root, filename)
+	if err != nil || strings.HasPrefix(rel, "..") {
+		return filepath.ToSlash(filename)
+	}
+
+	return filepath.ToSlash(rel)
+}
+
+// NormalizeMessage схлопывает пробелы в тексте диагностики.
+func NormalizeMessage(message string) string {
+	return strings.Join(strings.Fields(message), " ")
+}
+
+// EnclosingFunc возвращает имя функции верхнего уровня, содержащей pos:
+// "Func" или "Type.Method". Для позиции вне функций возвращается пустая строка.
+func EnclosingFunc(file *ast.File, pos token.Pos) string {
+	for _, decl := range file.Decls {
+		fn, ok := decl.(*ast.FuncDecl)
+		if !ok || pos < fn.Pos() || pos >= fn.End() {
+			continue
+		}
+
+		if fn.Recv != nil && len(fn.Recv.List) > 0 {
+			if recv := receiverName(fn.Recv.List[0].Type); recv != "" {
+				return recv + "." + fn.Name.Name
+			}
+		}
+
+		return fn.Name.Name
+	}
+
+	return ""
+}
+
+func receiverName(expr ast.Expr) string {
+	switch e := expr.(type) {
+	case *ast.StarExpr:
+		return receiverName(e.X)
+	case *ast.IndexExpr:
+		return receiverName(e.X)
+	case *ast.IndexListExpr:
+		return receiverName(e.X)
+	case *ast.ParenExpr:
+		return receiverName(e.X)
+	case *ast.Ident:
+		return e.Name
+	}
+
+	return ""
+}
@@ -0,0 +1,71 @@
+package baseline
+
+import (
+	"go/parser"
+	"go/token"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestWriteAndLoad(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	path := filepath.Join(dir, DefaultPath)
+	b, err := New(path)
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+
+	b.Entries = []Entry{
+		b.NewEntry("sensitive", filepath.Join(dir, "b", "b.go"), "", "log message   may contain\tsensitive data"),
+		b.NewEntry("lowercase", filepath.Join(dir, "a.go"), "main", "log message should start with a lowercase letter"),
+	}
+	if err := b.Write(path); err != nil {
+		t.Fatalf("Write() error = %v", err)
+	}
+
+	loaded, err := Load(path)
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+
+	if len(loaded.Entries) != 2 || loaded.Entries[0].File != "a.go" || loaded.Entries[1].File != "b/b.go" {
+		t.Fatalf("unexpected entries: %#v", loaded.Entries)
+	}
+
+	if got := loaded.Entries[1].Message; got != "log message may contain sensitive data" {
+		t.Fatalf("message is not normalized: %q", got)
+	}
+}
+
+func TestEnclosingFunc(t *testing.T) {
+	t.Parallel()
+
+	src := `package p
+
+var x = 1
+
+func plain() { _ = x }
+
+func (s *Server[T]) Start() { _ = x }
+`
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, "p.go", src, 0)
+	if err != nil {
+		t.Fatalf("ParseFile() error = %v", err)
+	}
+
+	tests := map[string]string{
+		"var x":              "",
+		"_ = x }\n\nfunc (s": "plain",
+		"_ = x }\n":          "Server.Start",
+	}
+	for needle, want := range tests {
+		pos := file.FileStart + token.Pos(strings.LastIndex(src, needle))
+		if got := EnclosingFunc(file, pos); got != want {
+			t.Fatalf("EnclosingFunc(%q) = %q, want %q", needle, got, want)
+		}
+	}
+}
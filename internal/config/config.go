@@ -3,7 +3,14 @@ package config
 import (
 	"encoding/json"
 	"errors"
+	"fmt"
 	"os"
+	"path/filepath"
+	"regexp"
+	"regexp/syntax"
+	"slices"
+	"sort"
+	"strings"
 )
 
 // DefaultPath — путь к конфигу loglint по умолчанию.
@@ -11,10 +18,129 @@ const DefaultPath = ".loglint.json"
 
 // Config содержит пользовательские настройки правил.
 type Config struct {
+	// Extends — путь к базовому конфигу относительно этого файла. Значения этого файла
+	// заменяют значения базового, карты объединяются по ключам.
+	Extends string `json:"extends"`
+	// Dir — абсолютный путь каталога конфига; относительно него заданы пути в конфиге.
+	Dir string `json:"-"`
+
 	SensitivePatterns []string          `json:"sensitive_patterns"`
 	CustomPatterns    map[string]string `json:"custom_patterns"`
 	AutoFix           bool              `json:"auto_fix"`
 	DisabledRules     []string          `json:"disabled_rules"`
+	Loggers           []Logger          `json:"loggers"`
+	KeyStyle          string            `json:"key_style"`
+	// SensitiveTypes — полные имена типов ("example.com/app/auth.Token"), которые нельзя
+	// выводить в лог целиком.
+	SensitiveTypes []string `json:"sensitive_types"`
+	// SecretDetectors настраивает встроенные детекторы секретов по их именам.
+	SecretDetectors map[string]SecretDetector `json:"secret_detectors"`
+	// PIIDetectors — имена включенных встроенных детекторов персональных данных.
+	PIIDetectors []string `json:"pii_detectors"`
+	// RedactFunc — функция маскирования ("example.com/app/redact.String"), в которую
+	// автоисправление оборачивает чувствительные значения.
+	RedactFunc string `json:"redact_func"`
+	// Baseline — путь к файлу baseline с известными находками относительно каталога конфига
+	// (Load делает его абсолютным); пустое значение отключает baseline.
+	Baseline string `json:"baseline"`
+	// Severity задает уровень важности правил: error, warning, info или off.
+	Severity map[string]string `json:"severity"`
+	// Overrides меняет настройки правил для отдельных каталогов, файлов и пакетов.
+	Overrides []Override `json:"overrides"`
+	// ExcludePaths — glob-шаблоны файлов относительно каталога конфига, которые не проверяются.
+	ExcludePaths []string `json:"exclude_paths"`
+	// LintGenerated включает проверку сгенерированных файлов ("// Code generated ... DO NOT EDIT.").
+	LintGenerated bool `json:"lint_generated"`
+	// Taint настраивает отслеживание секретов до вызовов логгеров (правило taint).
+	Taint Taint `json:"taint"`
+}
+
+// Taint включает правило taint и добавляет источники секретов к встроенным.
+type Taint struct {
+	Enabled bool          `json:"enabled"`
+	Sources []TaintSource `json:"sources"`
+}
+
+// TaintSource описывает источник секретов: результат вызова (kind: call), чтение поля
+// (kind: field) или аргумент вызова (kind: argument).
+type TaintSource struct {
+	Kind    string `json:"kind"`
+	Package string `json:"package"`
+	Type    string `json:"type"`
+	Name    string `json:"name"`
+	Arg     int    `json:"arg"`
+	Keyed   bool   `json:"keyed"`
+}
+
+// taintKinds — допустимые значения TaintSource.Kind.
+var taintKinds = map[string]struct{}{
+	"call":     {},
+	"field":    {},
+	"argument": {},
+}
+
+// SecretDetector меняет настройки встроенного детектора секретов: включает или отключает
+// его и задает пороги энтропии (бит на символ) и длины найденной строки.
+type SecretDetector struct {
+	Enabled    *bool   `json:"enabled"`
+	MinEntropy float64 `json:"min_entropy"`
+	MinLength  int     `json:"min_length"`
+}
+
+// Override задает настройки правил для файлов, подходящих под Paths (glob-шаблоны
+// относительно каталога конфига), или пакетов, подходящих под Packages.
+type Override struct {
+	// Dir — каталог, относительно которого заданы Paths; пустой — Config.Dir.
+	// Заполняется для переопределений из вложенных конфигов.
+	Dir               string            `json:"-"`
+	Paths             []string          `json:"paths"`
+	Packages          []string          `json:"packages"`
+	DisabledRules     []string          `json:"disabled_rules"`
+	SensitivePatterns []string          `json:"sensitive_patterns"`
+	Severity          map[string]string `json:"severity"`
+}
+
+// Logger описывает методы логгера, которые нужно проверять наравне со встроенными.
+type Logger struct {
+	Package      string   `json:"package"`
+	Type         string   `json:"type"`
+	Methods      []string `json:"methods"`
+	MessageIndex int      `json:"message_index"`
+	Kind         string   `json:"kind"`
+}
+
+// loggerKinds — допустимые значения Logger.Kind.
+var loggerKinds = map[string]struct{}{
+	"":          {},
+	"plain":     {},
+	"println":   {},
+	"printf":    {},
+	"key-value": {},
+}
+
+// keyStyles — допустимые значения Config.KeyStyle; пустое значение отключает правило keystyle.
+var keyStyles = []string{"snake_case", "camelCase", "kebab-case", "dotted"}
+
+// Rules — имена всех правил анализатора в порядке их описания в README.
+var Rules = []string{
+	"lowercase",
+	"english",
+	"specialchars",
+	"sensitive",
+	"sensitiveattrs",
+	"keystyle",
+	"kvpairs",
+	"directives",
+	"baseline",
+	"taint",
+}
+
+// severityLevels — допустимые значения Config.Severity.
+var severityLevels = map[string]struct{}{
+	"error":   {},
+	"warning": {},
+	"info":    {},
+	"off":     {},
 }
 
 // Defaults возвращает базовые настройки, если файл конфигурации не задан.
@@ -27,22 +153,24 @@ func Defaults() Config {
 	}
 }
 
-// Load читает конфиг по path; если файла нет, возвращаются настройки по умолчанию.
+// Load читает конфиг по path вместе с цепочкой extends; если файла нет,
+// возвращаются настройки по умолчанию.
 func Load(path string) (Config, error) {
 	if path == "" {
 		path = DefaultPath
 	}
 
-	cfg := Defaults()
-	raw, err := os.ReadFile(path)
+	dir, err := filepath.Abs(filepath.Dir(path))
 	if err != nil {
-		if errors.Is(err, os.ErrNotExist) {
-			return cfg, nil
-		}
 		return Config{}, err
 	}
 
-	if err := json.Unmarshal(raw, &cfg); err != nil {
+	cfg := Defaults()
+	cfg.Dir = dir
+	if err := decodeFile(path, &cfg, make(map[string]bool)); err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return cfg, nil
+		}
 		return Config{}, err
 	}
 
@@ -50,5 +178,207 @@ func Load(path string) (Config, error) {
 		cfg.CustomPatterns = map[string]string{}
 	}
 
+	if cfg.Baseline != "" && !filepath.IsAbs(cfg.Baseline) {
+		cfg.Baseline = filepath.Join(cfg.Dir, cfg.Baseline)
+	}
+
+	if err := cfg.Validate(); err != nil {
+		return Config{}, fmt.Errorf("%s: %w", path, err)
+	}
+
 	return cfg, nil
 }
+
+// decodeFile декодирует файл поверх cfg, предварительно применив его базовый конфиг.
+func decodeFile(path string, cfg *Config, seen map[string]bool) error {
+	abs, err := filepath.Abs(path)
+	if err != nil {
+		return err
+	}
+	if seen[abs] {
+		return fmt.Errorf("%s: extends cycle", path)
+	}
+	seen[abs] = true
+
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+
+	raw, err = toJSON(path, raw)
+	if err != nil {
+		return fmt.Errorf("%s: %w", path, err)
+	}
+
+	var head struct {
+		Extends string `json:"extends"`
+	}
+	if err := json.Unmarshal(raw, &head); err != nil {
+		return fmt.Errorf("%s: %w", path, err)
+	}
+
+	if head.Extends != "" {
+		if err := decodeFile(filepath.Join(filepath.Dir(path), head.Extends), cfg, seen); err != nil {
+			// %v, а не %w: отсутствующий базовый конфиг — ошибка, а не повод взять настройки по умолчанию.
+			return fmt.Errorf("%s: extends: %v", path, err)
+		}
+	}
+
+	if err := decodeStrict(raw, cfg); err != nil {
+		return fmt.Errorf("%s: %w", path, err)
+	}
+
+	return nil
+}
+
+// Validate проверяет значения конфига: regex-паттерны, описания логгеров, стиль ключей,
+// уровни важности правил, источники секретов и переопределения.
+// Ошибки по всем некорректным паттернам объединяются в одну.
+func (c Config) Validate() error {
+	if err := validateCustomPatterns(c.CustomPatterns); err != nil {
+		return err
+	}
+
+	if err := validateLoggers(c.Loggers); err != nil {
+		return err
+	}
+
+	if _, ok := NormalizeKeyStyle(c.KeyStyle); !ok {
+		return fmt.Errorf("unknown key_style %q", c.KeyStyle)
+	}
+
+	if err := validateSeverity(c.Severity); err != nil {
+		return err
+	}
+
+	if err := validateTaintSources(c.Taint.Sources); err != nil {
+		return err
+	}
+
+	for i, override := range c.Overrides {
+		if len(override.Paths) == 0 && len(override.Packages) == 0 {
+			return fmt.Errorf("overrides[%d]: paths or packages are required", i)
+		}
+
+		if err := validateSeverity(override.Severity); err != nil {
+			return fmt.Errorf("overrides[%d]: %w", i, err)
+		}
+	}
+
+	return nil
+}
+
+// NormalizeKeyStyle приводит стиль ключей к написанию из keyStyles без учета регистра и
+// пробелов по краям. Пустой стиль допустим и остается пустым; ok == false для неизвестного.
+func NormalizeKeyStyle(style string) (normalized string, ok bool) {
+	style = strings.TrimSpace(style)
+	if style == "" {
+		return "", true
+	}
+
+	for _, known := range keyStyles {
+		if strings.EqualFold(style, known) {
+			return known, true
+		}
+	}
+
+	return "", false
+}
+
+// CompilePattern компилирует пользовательский regex-паттерн. Ошибка называет ключ
+// паттерна и, если место ошибки однозначно, смещение в байтах, начиная с которого
+// выражение не удалось разобрать.
+func CompilePattern(name, pattern string) (*regexp.Regexp, error) {
+	re, err := regexp.Compile(pattern)
+	if err == nil {
+		return re, nil
+	}
+
+	// Для незакрытых скобок regexp называет все выражение, и смещение ничего не добавляет;
+	// если фрагмент встречается несколько раз, неизвестно, какое вхождение ошибочно.
+	var syntaxErr *syntax.Error
+	if errors.As(err, &syntaxErr) && syntaxErr.Expr != "" && syntaxErr.Expr != pattern &&
+		strings.Count(pattern, syntaxErr.Expr) == 1 {
+		return nil, fmt.Errorf("custom pattern %q: invalid regexp at offset %d: %s: %s",
+			name, strings.Index(pattern, syntaxErr.Expr), syntaxErr.Code, syntaxErr.Expr)
+	}
+
+	return nil, fmt.Errorf("custom pattern %q: %w", name, err)
+}
+
+func validateCustomPatterns(patterns map[string]string) error {
+	names := make([]string, 0, len(patterns))
+	for name := range patterns {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var errs []error
+	for _, name := range names {
+		if _, err := CompilePattern(name, patterns[name]); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func validateSeverity(levels map[string]string) error {
+	rules := make([]string, 0, len(levels))
+	for rule := range levels {
+		rules = append(rules, rule)
+	}
+	sort.Strings(rules)
+
+	// Имена и уровни сравниваются без учета регистра, как в анализаторе.
+	for _, rule := range rules {
+		if !slices.Contains(Rules, strings.ToLower(strings.TrimSpace(rule))) {
+			return fmt.Errorf("severity: unknown rule %q", rule)
+		}
+		if _, ok := severityLevels[strings.ToLower(strings.TrimSpace(levels[rule]))]; !ok {
+			return fmt.Errorf("severity: unknown level %q for rule %q", levels[rule], rule)
+		}
+	}
+
+	return nil
+}
+
+func validateLoggers(loggers []Logger) error {
+	for i, logger := range loggers {
+		switch {
+		case logger.Package == "":
+			return fmt.Errorf("loggers[%d]: package is required", i)
+		case len(logger.Methods) == 0:
+			return fmt.Errorf("loggers[%d]: at least one method is required", i)
+		case logger.MessageIndex < 0:
+			return fmt.Errorf("loggers[%d]: message_index must not be negative", i)
+		}
+
+		if _, ok := loggerKinds[logger.Kind]; !ok {
+			return fmt.Errorf("loggers[%d]: unknown kind %q", i, logger.Kind)
+		}
+	}
+
+	return nil
+}
+
+func validateTaintSources(sources []TaintSource) error {
+	for i, source := range sources {
+		switch {
+		case source.Package == "":
+			return fmt.Errorf("taint.sources[%d]: package is required", i)
+		case source.Name == "":
+			return fmt.Errorf("taint.sources[%d]: name is required", i)
+		case source.Kind == "field" && source.Type == "":
+			return fmt.Errorf("taint.sources[%d]: field source requires a type", i)
+		case source.Arg < 0:
+			return fmt.Errorf("taint.sources[%d]: arg must not be negative", i)
+		}
+
+		if _, ok := taintKinds[source.Kind]; !ok {
+			return fmt.Errorf("taint.sources[%d]: unknown kind %q", i, source.Kind)
+		}
+	}
+
+	return nil
+}
@@ -3,6 +3,8 @@ package config
 import (
 	"os"
 	"path/filepath"
+	"reflect"
+	"strings"
 	"testing"
 )
 
@@ -60,3 +62,208 @@ func TestLoadFromFile(t *testing.T) {
 		t.Fatalf("unexpected DisabledRules: %#v", cfg.DisabledRules)
 	}
 }
+
+func TestLoadLoggers(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	cfgPath := filepath.Join(dir, ".loglint.json")
+	content := `{
+		"loggers": [
+			{"package": "example.com/applog", "type": "Logger", "methods": ["Note"], "message_index": 0, "kind": "key-value"}
+		]
+	}`
+	if err := os.WriteFile(cfgPath, []byte(content), 0o600); err != nil {
+		t.Fatalf("WriteFile() error = %v", err)
+	}
+
+	cfg, err := Load(cfgPath)
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+
+	if len(cfg.Loggers) != 1 || cfg.Loggers[0].Kind != "key-value" || cfg.Loggers[0].Methods[0] != "Note" {
+		t.Fatalf("unexpected Loggers: %#v", cfg.Loggers)
+	}
+}
+
+func TestLoadRejectsUnknownLoggerKind(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	cfgPath := filepath.Join(dir, ".loglint.json")
+	content := `{"loggers": [{"package": "example.com/applog", "methods": ["Note"], "kind": "structured"}]}`
+	if err := os.WriteFile(cfgPath, []byte(content), 0o600); err != nil {
+		t.Fatalf("WriteFile() error = %v", err)
+	}
+
+	if _, err := Load(cfgPath); err == nil {
+		t.Fatalf("expected error for unknown logger kind")
+	}
+}
+
+func TestLoadRejectsInvalidCustomPatterns(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	cfgPath := filepath.Join(dir, ".loglint.json")
+	content := `{"custom_patterns": {"order": "\\d{4}", "email": "[a-z+@", "card": "(\\d{4}", "id": "id-\\d**"}}`
+	if err := os.WriteFile(cfgPath, []byte(content), 0o600); err != nil {
+		t.Fatalf("WriteFile() error = %v", err)
+	}
+
+	_, err := Load(cfgPath)
+	if err == nil {
+		t.Fatalf("expected error for invalid custom patterns")
+	}
+
+	for _, want := range []string{
+		cfgPath,
+		"custom pattern \"card\": error parsing regexp: missing closing ): `(\\d{4}`",
+		"custom pattern \"email\": error parsing regexp: missing closing ]: `[a-z+@`",
+		`custom pattern "id": invalid regexp at offset 5: invalid nested repetition operator: **`,
+	} {
+		if !strings.Contains(err.Error(), want) {
+			t.Fatalf("error %q does not contain %q", err, want)
+		}
+	}
+
+	if strings.Contains(err.Error(), `"order"`) {
+		t.Fatalf("error %q mentions a valid pattern", err)
+	}
+}
+
+func TestLoadRejectsUnknownSeverity(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	cfgPath := filepath.Join(dir, ".loglint.json")
+	content := `{"severity": {"sensitive": "error", "lowercase": "fatal"}}`
+	if err := os.WriteFile(cfgPath, []byte(content), 0o600); err != nil {
+		t.Fatalf("WriteFile() error = %v", err)
+	}
+
+	_, err := Load(cfgPath)
+	if err == nil || !strings.Contains(err.Error(), `unknown level "fatal" for rule "lowercase"`) {
+		t.Fatalf("expected error for unknown severity level, got %v", err)
+	}
+}
+
+func TestNormalizeKeyStyle(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		style  string
+		want   string
+		wantOK bool
+	}{
+		{style: "", want: "", wantOK: true},
+		{style: "snake_case", want: "snake_case", wantOK: true},
+		{style: " CamelCase ", want: "camelCase", wantOK: true},
+		{style: "KEBAB-CASE", want: "kebab-case", wantOK: true},
+		{style: "PascalCase"},
+	}
+
+	for _, tt := range tests {
+		got, ok := NormalizeKeyStyle(tt.style)
+		if got != tt.want || ok != tt.wantOK {
+			t.Errorf("NormalizeKeyStyle(%q) = %q, %v, want %q, %v", tt.style, got, ok, tt.want, tt.wantOK)
+		}
+		if err := (Config{KeyStyle: tt.style}).Validate(); (err == nil) != tt.wantOK {
+			t.Errorf("Validate() with key_style %q error = %v", tt.style, err)
+		}
+	}
+}
+
+func TestValidateSeverityRules(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		config  Config
+		wantErr string
+	}{
+		{name: "case-insensitive", config: Config{Severity: map[string]string{"Sensitive": "Warning", " lowercase ": "off"}}},
+		{name: "unknown rule", config: Config{Severity: map[string]string{"sensitve": "error"}}, wantErr: `severity: unknown rule "sensitve"`},
+		{
+			name:    "unknown rule in override",
+			config:  Config{Overrides: []Override{{Paths: []string{"a"}, Severity: map[string]string{"upper": "info"}}}},
+			wantErr: `overrides[0]: severity: unknown rule "upper"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := tt.config.Validate()
+			if tt.wantErr == "" && err != nil || tt.wantErr != "" && (err == nil || err.Error() != tt.wantErr) {
+				t.Fatalf("Validate() error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestLoadTaint(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	cfgPath := filepath.Join(dir, ".loglint.json")
+	content := `{"taint": {"enabled": true, "sources": [{"kind": "field", "package": "example.com/app", "type": "Config", "name": "DSN"}]}}`
+	if err := os.WriteFile(cfgPath, []byte(content), 0o600); err != nil {
+		t.Fatalf("WriteFile() error = %v", err)
+	}
+
+	cfg, err := Load(cfgPath)
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+
+	want := Taint{Enabled: true, Sources: []TaintSource{{Kind: "field", Package: "example.com/app", Type: "Config", Name: "DSN"}}}
+	if !reflect.DeepEqual(cfg.Taint, want) {
+		t.Fatalf("Taint = %+v, want %+v", cfg.Taint, want)
+	}
+
+	content = `{"taint": {"sources": [{"kind": "global", "package": "os", "name": "Args"}]}}`
+	if err := os.WriteFile(cfgPath, []byte(content), 0o600); err != nil {
+		t.Fatalf("WriteFile() error = %v", err)
+	}
+
+	if _, err := Load(cfgPath); err == nil || !strings.Contains(err.Error(), `taint.sources[0]: unknown kind "global"`) {
+		t.Fatalf("expected error for unknown taint source kind, got %v", err)
+	}
+}
+
+func TestLoadOverrides(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	cfgPath := filepath.Join(dir, ".loglint.json")
+	content := `{
+		"overrides": [
+			{"paths": ["**/*_test.go"], "disabled_rules": ["sensitive"]},
+			{"packages": ["example.com/app/cmd/..."], "severity": {"lowercase": "warning"}}
+		]
+	}`
+	if err := os.WriteFile(cfgPath, []byte(content), 0o600); err != nil {
+		t.Fatalf("WriteFile() error = %v", err)
+	}
+
+	cfg, err := Load(cfgPath)
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+
+	if len(cfg.Overrides) != 2 || cfg.Overrides[0].Paths[0] != "**/*_test.go" || cfg.Overrides[1].Severity["lowercase"] != "warning" {
+		t.Fatalf("unexpected Overrides: %#v", cfg.Overrides)
+	}
+
+	content = `{"overrides": [{"disabled_rules": ["sensitive"]}]}`
+	if err := os.WriteFile(cfgPath, []byte(content), 0o600); err != nil {
+		t.Fatalf("WriteFile() error = %v", err)
+	}
+
+	if _, err := Load(cfgPath); err == nil {
+		t.Fatalf("expected error for override without paths and packages")
+	}
+}
@@ -0,0 +1,59 @@
+package config
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/BurntSushi/toml"
+	"gopkg.in/yaml.v3"
+)
+
+// FileNames — имена файлов конфига в порядке поиска. Формат определяется по расширению.
+var FileNames = []string{DefaultPath, ".loglint.yml", ".loglint.yaml", ".loglint.toml"}
+
+// isConfigName сообщает, является ли name одним из FileNames.
+func isConfigName(name string) bool {
+	for _, known := range FileNames {
+		if name == known {
+			return true
+		}
+	}
+
+	return false
+}
+
+// toJSON приводит YAML и TOML к JSON, чтобы у всех форматов была одна схема
+// (теги json в Config) и одинаково строгое декодирование.
+func toJSON(path string, raw []byte) ([]byte, error) {
+	var value map[string]any
+	switch ext := strings.ToLower(filepath.Ext(path)); ext {
+	case ".json":
+		return raw, nil
+	case ".yml", ".yaml":
+		if err := yaml.Unmarshal(raw, &value); err != nil {
+			return nil, err
+		}
+	case ".toml":
+		if err := toml.Unmarshal(raw, &value); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, fmt.Errorf("unsupported config format %q", ext)
+	}
+
+	if value == nil {
+		return []byte("{}"), nil
+	}
+
+	return json.Marshal(value)
+}
+
+// decodeStrict декодирует JSON в cfg и сообщает о неизвестных ключах.
+func decodeStrict(raw []byte, cfg *Config) error {
+	decoder := json.NewDecoder(bytes.NewReader(raw))
+	decoder.DisallowUnknownFields()
+	return decoder.Decode(cfg)
+}
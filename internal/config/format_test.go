@@ -0,0 +1,106 @@
+package config
+
+import (
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestLoadYAMLAndTOML(t *testing.T) {
+	t.Parallel()
+
+	files := map[string]string{
+		".loglint.yml": `
+sensitive_patterns: [session]
+key_style: snake_case
+severity:
+  lowercase: warning
+loggers:
+  - package: example.com/applog
+    methods: [Note]
+    message_index: 1
+    kind: key-value
+`,
+		".loglint.toml": `
+sensitive_patterns = ["session"]
+key_style = "snake_case"
+
+[severity]
+lowercase = "warning"
+
+[[loggers]]
+package = "example.com/applog"
+methods = ["Note"]
+message_index = 1
+kind = "key-value"
+`,
+	}
+
+	for name, content := range files {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			cfgPath := filepath.Join(t.TempDir(), name)
+			writeFile(t, cfgPath, content)
+
+			cfg, err := Load(cfgPath)
+			if err != nil {
+				t.Fatalf("Load() error = %v", err)
+			}
+
+			if !reflect.DeepEqual(cfg.SensitivePatterns, []string{"session"}) || cfg.KeyStyle != "snake_case" {
+				t.Fatalf("unexpected config: %+v", cfg)
+			}
+			if cfg.Severity["lowercase"] != "warning" {
+				t.Fatalf("Severity = %v", cfg.Severity)
+			}
+			want := []Logger{{Package: "example.com/applog", Methods: []string{"Note"}, MessageIndex: 1, Kind: "key-value"}}
+			if !reflect.DeepEqual(cfg.Loggers, want) {
+				t.Fatalf("Loggers = %+v, want %+v", cfg.Loggers, want)
+			}
+		})
+	}
+}
+
+func TestLoadRejectsUnknownKeys(t *testing.T) {
+	t.Parallel()
+
+	files := map[string]string{
+		DefaultPath:     `{"sensitive_pattern": ["session"]}`,
+		".loglint.yaml": "overrides:\n  - paths: [cmd/**]\n    disable: [lowercase]\n",
+		".loglint.toml": "sensitive_pattern = [\"session\"]\n",
+	}
+
+	for name, content := range files {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			cfgPath := filepath.Join(t.TempDir(), name)
+			writeFile(t, cfgPath, content)
+
+			_, err := Load(cfgPath)
+			if err == nil || !strings.Contains(err.Error(), "unknown field") {
+				t.Fatalf("expected unknown field error, got %v", err)
+			}
+		})
+	}
+}
+
+func TestDiscoverRejectsSeveralConfigs(t *testing.T) {
+	t.Parallel()
+
+	root := t.TempDir()
+	writeFile(t, filepath.Join(root, "go.mod"), "module example.com/app\n")
+	writeFile(t, filepath.Join(root, ".loglint.yml"), "key_style: snake_case\n")
+
+	path, err := Discover(root)
+	if err != nil || path != filepath.Join(root, ".loglint.yml") {
+		t.Fatalf("Discover() = %q, %v", path, err)
+	}
+
+	writeFile(t, filepath.Join(root, ".loglint.toml"), "")
+	if _, err := Discover(root); err == nil || !strings.Contains(err.Error(), "several config files") {
+		t.Fatalf("expected error for several configs, got %v", err)
+	}
+}
@@ -0,0 +1,223 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+)
+
+// Discover ищет конфиг (любое из FileNames), начиная с каталога dir и поднимаясь вверх
+// до корня модуля (каталога с go.mod) включительно, и возвращает самый верхний из найденных:
+// он корневой, а конфиги ниже LoadTree применяет как вложенные. Если конфиг не найден,
+// возвращается пустая строка.
+func Discover(dir string) (string, error) {
+	dir, err := filepath.Abs(dir)
+	if err != nil {
+		return "", err
+	}
+
+	var topmost string
+	for {
+		found, err := configInDir(dir)
+		if err != nil {
+			return "", err
+		}
+		if found != "" {
+			topmost = found
+		}
+
+		if _, err := os.Stat(filepath.Join(dir, "go.mod")); err == nil {
+			return topmost, nil
+		}
+
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return topmost, nil
+		}
+		dir = parent
+	}
+}
+
+// configInDir возвращает путь к конфигу в каталоге dir или пустую строку.
+// Несколько конфигов в одном каталоге — ошибка: непонятно, какой из них главный.
+func configInDir(dir string) (string, error) {
+	var found string
+	for _, name := range FileNames {
+		candidate := filepath.Join(dir, name)
+		if _, err := os.Stat(candidate); errors.Is(err, os.ErrNotExist) {
+			continue
+		} else if err != nil {
+			return "", err
+		}
+
+		if found != "" {
+			return "", fmt.Errorf("%s: several config files: %s and %s", dir, filepath.Base(found), name)
+		}
+		found = candidate
+	}
+
+	return found, nil
+}
+
+// LoadTree загружает конфиг по path, а если path пустой — корневой конфиг, найденный через
+// Discover от текущего каталога. Вложенные конфиги в подкаталогах, в том числе в текущем,
+// становятся переопределениями для своих поддеревьев (см. Config.addNested).
+func LoadTree(path string) (Config, error) {
+	if path == "" {
+		found, err := Discover(".")
+		if err != nil {
+			return Config{}, err
+		}
+		path = found
+	}
+
+	if path == "" {
+		return Load(DefaultPath)
+	}
+
+	cfg, err := Load(path)
+	if err != nil {
+		return Config{}, err
+	}
+
+	if err := cfg.addNested(path); err != nil {
+		return Config{}, err
+	}
+
+	return cfg, nil
+}
+
+// addNested находит вложенные конфиги в подкаталогах cfg.Dir. Вложенный конфиг может задавать
+// только настройки, которые переопределяются для части кода: disabled_rules,
+// sensitive_patterns, severity, exclude_paths и overrides. Остальные настройки его базовых
+// конфигов (extends) не действуют: они задаются корневым конфигом. Вложенные модули
+// (каталоги со своим go.mod) пропускаются, как и в Discover.
+func (c *Config) addNested(rootPath string) error {
+	rootPath, err := filepath.Abs(rootPath)
+	if err != nil {
+		return err
+	}
+
+	return filepath.WalkDir(c.Dir, func(file string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if entry.IsDir() {
+			name := entry.Name()
+			if file == c.Dir {
+				return nil
+			}
+			if name == "vendor" || name == "testdata" || strings.HasPrefix(name, ".") || strings.HasPrefix(name, "_") {
+				return filepath.SkipDir
+			}
+			if _, err := os.Stat(filepath.Join(file, "go.mod")); err == nil {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+
+		if !isConfigName(entry.Name()) {
+			return nil
+		}
+
+		if _, err := configInDir(filepath.Dir(file)); err != nil {
+			return err
+		}
+
+		if file == rootPath {
+			return nil
+		}
+
+		own, err := decodeOwn(file)
+		if err != nil {
+			return err
+		}
+
+		if err := checkNested(own); err != nil {
+			return fmt.Errorf("%s: %w", file, err)
+		}
+
+		nested, err := Load(file)
+		if err != nil {
+			return err
+		}
+
+		if err := c.applyNested(nested); err != nil {
+			return fmt.Errorf("%s: %w", file, err)
+		}
+
+		return nil
+	})
+}
+
+// decodeOwn декодирует только сам файл конфига, без цепочки extends.
+func decodeOwn(path string) (Config, error) {
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return Config{}, err
+	}
+
+	raw, err = toJSON(path, raw)
+	if err != nil {
+		return Config{}, fmt.Errorf("%s: %w", path, err)
+	}
+
+	var cfg Config
+	if err := decodeStrict(raw, &cfg); err != nil {
+		return Config{}, fmt.Errorf("%s: %w", path, err)
+	}
+
+	return cfg, nil
+}
+
+// checkNested проверяет, что вложенный конфиг сам задает только переопределяемые настройки.
+func checkNested(own Config) error {
+	switch {
+	case len(own.CustomPatterns) > 0, len(own.SensitiveTypes) > 0, len(own.SecretDetectors) > 0,
+		len(own.PIIDetectors) > 0, own.RedactFunc != "", len(own.Loggers) > 0, own.KeyStyle != "",
+		own.Baseline != "", own.LintGenerated, own.Taint.Enabled, len(own.Taint.Sources) > 0:
+		return errors.New("nested config may only set disabled_rules, sensitive_patterns, severity, exclude_paths and overrides")
+	}
+
+	return nil
+}
+
+func (c *Config) applyNested(nested Config) error {
+	if len(nested.DisabledRules) > 0 || len(nested.SensitivePatterns) > 0 || len(nested.Severity) > 0 {
+		c.Overrides = append(c.Overrides, Override{
+			Dir:               nested.Dir,
+			Paths:             []string{"**"},
+			DisabledRules:     nested.DisabledRules,
+			SensitivePatterns: nested.SensitivePatterns,
+			Severity:          nested.Severity,
+		})
+	}
+
+	for _, override := range nested.Overrides {
+		if override.Dir == "" {
+			override.Dir = nested.Dir
+		}
+		c.Overrides = append(c.Overrides, override)
+	}
+
+	rel, err := filepath.Rel(c.Dir, nested.Dir)
+	if err != nil {
+		return err
+	}
+	rel = filepath.ToSlash(rel)
+
+	for _, pattern := range nested.ExcludePaths {
+		// Шаблон без "/" сравнивается с именем файла в любом подкаталоге.
+		if !strings.Contains(pattern, "/") {
+			pattern = "**/" + pattern
+		}
+		c.ExcludePaths = append(c.ExcludePaths, path.Join(rel, pattern))
+	}
+
+	return nil
+}
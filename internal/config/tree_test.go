@@ -0,0 +1,188 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func writeFile(t *testing.T, path, content string) {
+	t.Helper()
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		t.Fatalf("MkdirAll() error = %v", err)
+	}
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatalf("WriteFile() error = %v", err)
+	}
+}
+
+func TestDiscoverWalksUpToModuleRoot(t *testing.T) {
+	t.Parallel()
+
+	root := t.TempDir()
+	writeFile(t, filepath.Join(root, "go.mod"), "module example.com/app\n")
+	writeFile(t, filepath.Join(root, DefaultPath), `{}`)
+	sub := filepath.Join(root, "internal", "service")
+	if err := os.MkdirAll(sub, 0o755); err != nil {
+		t.Fatalf("MkdirAll() error = %v", err)
+	}
+
+	path, err := Discover(sub)
+	if err != nil {
+		t.Fatalf("Discover() error = %v", err)
+	}
+	if path != filepath.Join(root, DefaultPath) {
+		t.Fatalf("Discover() = %q", path)
+	}
+
+	// Конфиг выше корня модуля не учитывается.
+	module := filepath.Join(root, "tools")
+	writeFile(t, filepath.Join(module, "go.mod"), "module example.com/tools\n")
+	if path, err := Discover(module); err != nil || path != "" {
+		t.Fatalf("Discover() = %q, %v; want no config", path, err)
+	}
+}
+
+// Тест меняет текущий каталог и поэтому не параллельный.
+func TestLoadTreeFromNestedConfigDir(t *testing.T) {
+	root := t.TempDir()
+	writeFile(t, filepath.Join(root, "go.mod"), "module example.com/app\n")
+	writeFile(t, filepath.Join(root, DefaultPath), `{
+		"disabled_rules": ["english"],
+		"custom_patterns": {"order": "order-\\d+"}
+	}`)
+	sub := filepath.Join(root, "cmd")
+	writeFile(t, filepath.Join(sub, ".loglint.yml"), "severity:\n  lowercase: warning\n")
+
+	path, err := Discover(sub)
+	if err != nil || path != filepath.Join(root, DefaultPath) {
+		t.Fatalf("Discover() = %q, %v; want the module root config", path, err)
+	}
+
+	t.Chdir(sub)
+	cfg, err := LoadTree("")
+	if err != nil {
+		t.Fatalf("LoadTree() error = %v", err)
+	}
+
+	if cfg.Dir != root || !reflect.DeepEqual(cfg.DisabledRules, []string{"english"}) || cfg.CustomPatterns["order"] == "" {
+		t.Fatalf("root config dropped: %+v", cfg)
+	}
+	if len(cfg.Overrides) != 1 || cfg.Overrides[0].Dir != sub || cfg.Overrides[0].Severity["lowercase"] != "warning" {
+		t.Fatalf("nested config is not an override: %+v", cfg.Overrides)
+	}
+}
+
+func TestLoadExtends(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	writeFile(t, filepath.Join(dir, "org", "base.json"), `{
+		"sensitive_patterns": ["session"],
+		"custom_patterns": {"email": ".+@.+"},
+		"severity": {"sensitive": "error", "lowercase": "warning"},
+		"key_style": "snake_case"
+	}`)
+	cfgPath := filepath.Join(dir, "team", DefaultPath)
+	writeFile(t, cfgPath, `{
+		"extends": "../org/base.json",
+		"custom_patterns": {"order": "\\d{4}"},
+		"severity": {"lowercase": "off"}
+	}`)
+
+	cfg, err := Load(cfgPath)
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+
+	if len(cfg.SensitivePatterns) != 1 || cfg.KeyStyle != "snake_case" {
+		t.Fatalf("base values are lost: %#v", cfg)
+	}
+	if len(cfg.CustomPatterns) != 2 || cfg.Severity["sensitive"] != "error" || cfg.Severity["lowercase"] != "off" {
+		t.Fatalf("maps are not merged: %#v %#v", cfg.CustomPatterns, cfg.Severity)
+	}
+}
+
+func TestLoadExtendsErrors(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	missing := filepath.Join(dir, "missing", DefaultPath)
+	writeFile(t, missing, `{"extends": "base.json"}`)
+	if _, err := Load(missing); err == nil || !strings.Contains(err.Error(), "extends") {
+		t.Fatalf("expected error for missing base config, got %v", err)
+	}
+
+	cycle := filepath.Join(dir, "cycle", DefaultPath)
+	writeFile(t, cycle, `{"extends": "other.json"}`)
+	writeFile(t, filepath.Join(dir, "cycle", "other.json"), `{"extends": ".loglint.json"}`)
+	if _, err := Load(cycle); err == nil || !strings.Contains(err.Error(), "extends cycle") {
+		t.Fatalf("expected extends cycle error, got %v", err)
+	}
+}
+
+func TestLoadTreeNested(t *testing.T) {
+	t.Parallel()
+
+	root := t.TempDir()
+	writeFile(t, filepath.Join(root, DefaultPath), `{"disabled_rules": ["english"]}`)
+	writeFile(t, filepath.Join(root, "cmd", DefaultPath), `{
+		"severity": {"lowercase": "warning"},
+		"exclude_paths": ["*.pb.go", "gen/**"],
+		"overrides": [{"paths": ["*_test.go"], "disabled_rules": ["sensitive"]}]
+	}`)
+	writeFile(t, filepath.Join(root, "testdata", DefaultPath), `{"key_style": "camelCase"}`)
+
+	cfg, err := LoadTree(filepath.Join(root, DefaultPath))
+	if err != nil {
+		t.Fatalf("LoadTree() error = %v", err)
+	}
+
+	cmdDir := filepath.Join(root, "cmd")
+	if len(cfg.Overrides) != 2 || cfg.Overrides[0].Dir != cmdDir || cfg.Overrides[0].Paths[0] != "**" ||
+		cfg.Overrides[0].Severity["lowercase"] != "warning" || cfg.Overrides[1].Dir != cmdDir {
+		t.Fatalf("unexpected Overrides: %#v", cfg.Overrides)
+	}
+
+	if strings.Join(cfg.ExcludePaths, ",") != "cmd/**/*.pb.go,cmd/gen/**" {
+		t.Fatalf("unexpected ExcludePaths: %#v", cfg.ExcludePaths)
+	}
+
+	// Вложенный модуль проверяется своим конфигом, а не как поддерево.
+	writeFile(t, filepath.Join(root, "tools", "go.mod"), "module tools\n")
+	writeFile(t, filepath.Join(root, "tools", DefaultPath), `{"key_style": "camelCase"}`)
+	if _, err := LoadTree(filepath.Join(root, DefaultPath)); err != nil {
+		t.Fatalf("LoadTree() with nested module error = %v", err)
+	}
+
+	writeFile(t, filepath.Join(root, "internal", DefaultPath), `{"key_style": "camelCase"}`)
+	if _, err := LoadTree(filepath.Join(root, DefaultPath)); err == nil || !strings.Contains(err.Error(), "nested config may only set") {
+		t.Fatalf("expected error for unsupported nested setting, got %v", err)
+	}
+}
+
+func TestLoadTreeNestedExtends(t *testing.T) {
+	t.Parallel()
+
+	root := t.TempDir()
+	writeFile(t, filepath.Join(root, "org.json"), `{
+		"key_style": "snake_case",
+		"custom_patterns": {"order": "ORD-\\d+"},
+		"disabled_rules": ["english"]
+	}`)
+	writeFile(t, filepath.Join(root, DefaultPath), `{"extends": "org.json"}`)
+	writeFile(t, filepath.Join(root, "team", DefaultPath), `{"extends": "../org.json", "severity": {"lowercase": "warning"}}`)
+
+	cfg, err := LoadTree(filepath.Join(root, DefaultPath))
+	if err != nil {
+		t.Fatalf("LoadTree() error = %v", err)
+	}
+
+	if len(cfg.Overrides) != 1 || cfg.Overrides[0].Dir != filepath.Join(root, "team") ||
+		!reflect.DeepEqual(cfg.Overrides[0].DisabledRules, []string{"english"}) || cfg.Overrides[0].Severity["lowercase"] != "warning" {
+		t.Fatalf("unexpected Overrides: %#v", cfg.Overrides)
+	}
+}
@@ -2,16 +2,127 @@ package loglint
 
 import (
 	internalanalyzer "github.com/victornechaev/loglint/internal/analyzer"
+	"github.com/victornechaev/loglint/internal/baseline"
 	"golang.org/x/tools/go/analysis"
 )
 
 // Options задает поведение правил анализатора loglint.
 type Options = internalanalyzer.Options
 
+// LoggerSpec описывает пользовательский логгер для реестра loglint.
+type LoggerSpec = internalanalyzer.LoggerSpec
+
+// Override меняет настройки правил для отдельных каталогов, файлов и пакетов.
+type Override = internalanalyzer.Override
+
+// RuleDoc описывает правило loglint для отчетов.
+type RuleDoc = internalanalyzer.RuleDoc
+
+// Rules возвращает описания всех правил loglint.
+func Rules() []RuleDoc {
+	return internalanalyzer.Rules()
+}
+
+// TaintSource описывает источник секретов для правила taint.
+type TaintSource = internalanalyzer.TaintSource
+
+// Виды источников секретов для TaintSource.Kind.
+const (
+	TaintCall     = internalanalyzer.TaintCall
+	TaintField    = internalanalyzer.TaintField
+	TaintArgument = internalanalyzer.TaintArgument
+)
+
+// SecretDetector меняет настройки встроенного детектора секретов для Options.SecretDetectors.
+type SecretDetector = internalanalyzer.SecretDetector
+
+// Имена встроенных детекторов секретов.
+const (
+	SecretAWSAccessKey = internalanalyzer.SecretAWSAccessKey
+	SecretJWT          = internalanalyzer.SecretJWT
+	SecretGitHubToken  = internalanalyzer.SecretGitHubToken
+	SecretPrivateKey   = internalanalyzer.SecretPrivateKey
+	SecretBase64       = internalanalyzer.SecretBase64
+	SecretHex          = internalanalyzer.SecretHex
+)
+
+// Имена встроенных детекторов персональных данных для Options.PIIDetectors.
+const (
+	PIICreditCard = internalanalyzer.PIICreditCard
+	PIIIBAN       = internalanalyzer.PIIIBAN
+	PIIEmail      = internalanalyzer.PIIEmail
+	PIIPhone      = internalanalyzer.PIIPhone
+	PIIIPv4       = internalanalyzer.PIIIPv4
+	PIIIPv6       = internalanalyzer.PIIIPv6
+	PIIUSSSN      = internalanalyzer.PIIUSSSN
+	PIIRUINN      = internalanalyzer.PIIRUINN
+	PIIRUSNILS    = internalanalyzer.PIIRUSNILS
+)
+
+// Baseline — известные находки, которые не нужно сообщать повторно.
+type Baseline = baseline.Baseline
+
+// BaselineEntry — одна известная находка в Baseline.
+type BaselineEntry = baseline.Entry
+
+// LoadBaseline читает baseline, записанный командой `loglint baseline write`.
+func LoadBaseline(path string) (*Baseline, error) {
+	return baseline.Load(path)
+}
+
+// Способы сборки сообщения для LoggerSpec.Kind.
+const (
+	KindPlain    = internalanalyzer.KindPlain
+	KindPrintln  = internalanalyzer.KindPrintln
+	KindPrintf   = internalanalyzer.KindPrintf
+	KindKeyValue = internalanalyzer.KindKeyValue
+)
+
+// Стили именования ключей атрибутов для Options.KeyStyle.
+const (
+	KeyStyleSnake  = internalanalyzer.KeyStyleSnake
+	KeyStyleCamel  = internalanalyzer.KeyStyleCamel
+	KeyStyleKebab  = internalanalyzer.KeyStyleKebab
+	KeyStyleDotted = internalanalyzer.KeyStyleDotted
+)
+
+// Уровни важности правил для Options.Severity.
+const (
+	SeverityError   = internalanalyzer.SeverityError
+	SeverityWarning = internalanalyzer.SeverityWarning
+	SeverityInfo    = internalanalyzer.SeverityInfo
+	SeverityOff     = internalanalyzer.SeverityOff
+)
+
+// SplitCategory разбирает analysis.Diagnostic.Category диагностики loglint
+// на имя правила и уровень важности.
+func SplitCategory(category string) (rule, severity string) {
+	return internalanalyzer.SplitCategory(category)
+}
+
 // Analyzer — анализатор по умолчанию для тестов и простого подключения.
-var Analyzer = internalanalyzer.New(Options{})
+var Analyzer = mustNewAnalyzer(Options{})
 
 // NewAnalyzer создает новый анализатор с явно заданными параметрами.
-func NewAnalyzer(options Options) *analysis.Analyzer {
+// Возвращает ошибку, если параметры некорректны (например, regex не компилируется).
+func NewAnalyzer(options Options) (*analysis.Analyzer, error) {
 	return internalanalyzer.New(options)
 }
+
+// LogCall — вызов логгера в результате анализатора NewInventory.
+type LogCall = internalanalyzer.LogCall
+
+// NewInventory создает анализатор, результатом которого являются все вызовы логгеров
+// пакета ([]LogCall). Диагностик он не сообщает.
+func NewInventory(options Options) (*analysis.Analyzer, error) {
+	return internalanalyzer.NewInventory(options)
+}
+
+func mustNewAnalyzer(options Options) *analysis.Analyzer {
+	analyzer, err := NewAnalyzer(options)
+	if err != nil {
+		panic(err)
+	}
+
+	return analyzer
+}
@@ -1,9 +1,13 @@
 package loglint_test
 
 import (
+	"path/filepath"
+	"reflect"
+	"strings"
 	"testing"
 
 	"github.com/victornechaev/loglint/pkg/loglint"
+	"golang.org/x/tools/go/analysis"
 	"golang.org/x/tools/go/analysis/analysistest"
 )
 
@@ -11,7 +15,14 @@ func TestAnalyzer(t *testing.T) {
 	t.Parallel()
 
 	testdata := analysistest.TestData()
-	analysistest.Run(t, testdata, loglint.Analyzer, "a", "ok")
+	analysistest.Run(t, testdata, loglint.Analyzer, "a", "ok", "directives", "logruscalls", "zerologcalls", "printfcalls", "attrs", "kvpairs")
+}
+
+func TestLoggerWrappers(t *testing.T) {
+	t.Parallel()
+
+	testdata := analysistest.TestData()
+	analysistest.Run(t, testdata, loglint.Analyzer, "wrapperlib", "wrappers")
 }
 
 func TestSuggestedFixes(t *testing.T) {
@@ -28,7 +39,7 @@ func TestDisabledRule(t *testing.T) {
 	analysistest.Run(
 		t,
 		testdata,
-		loglint.NewAnalyzer(loglint.Options{DisabledRules: []string{"lowercase"}}),
+		newAnalyzer(t, loglint.Options{DisabledRules: []string{"lowercase"}}),
 		"configdisabled",
 	)
 }
@@ -40,11 +51,333 @@ func TestCustomSensitivePattern(t *testing.T) {
 	analysistest.Run(
 		t,
 		testdata,
-		loglint.NewAnalyzer(loglint.Options{
+		newAnalyzer(t, loglint.Options{
+			SensitivePatterns: []string{"session", "token"},
 			CustomPatterns: map[string]string{
 				"order-id": `\b\d{4}\b`,
+				"email":    `[a-z]+@[a-z]+\.[a-z]{2,}`,
 			},
 		}),
 		"custompattern",
 	)
 }
+
+func TestCustomLoggers(t *testing.T) {
+	t.Parallel()
+
+	testdata := analysistest.TestData()
+	analysistest.Run(
+		t,
+		testdata,
+		newAnalyzer(t, loglint.Options{
+			Loggers: []loglint.LoggerSpec{
+				{Package: "example.com/applog", Type: "Logger", Methods: []string{"Note"}, Kind: loglint.KindKeyValue},
+				{Package: "example.com/applog", Type: "Logger", Methods: []string{"Notef"}, Kind: loglint.KindPrintf},
+				{Package: "example.com/applog", Methods: []string{"Say"}, MessageIndex: 1},
+			},
+		}),
+		"customlogger",
+	)
+}
+
+func TestKeyStyle(t *testing.T) {
+	t.Parallel()
+
+	testdata := analysistest.TestData()
+	analysistest.RunWithSuggestedFixes(
+		t,
+		testdata,
+		newAnalyzer(t, loglint.Options{KeyStyle: loglint.KeyStyleSnake}),
+		"keystyle",
+	)
+}
+
+func TestBaseline(t *testing.T) {
+	t.Parallel()
+
+	testdata := analysistest.TestData()
+	lowercase := "log message should start with a lowercase letter"
+	analysistest.Run(
+		t,
+		testdata,
+		newAnalyzer(t, loglint.Options{
+			Baseline: &loglint.Baseline{
+				Root: filepath.Join(testdata, "src", "baselined"),
+				Entries: []loglint.BaselineEntry{
+					{Rule: "lowercase", File: "baselined.go", Func: "Server.Start", Message: lowercase},
+					{Rule: "sensitive", File: "baselined.go", Func: "legacy", Message: `log message may contain sensitive data (default pattern: token, matched "token: ")`},
+					{Rule: "lowercase", File: "baselined.go", Func: "cleaned", Message: lowercase},
+					{Rule: "lowercase", File: "other.go", Func: "main", Message: lowercase},
+				},
+			},
+		}),
+		"baselined",
+	)
+}
+
+func TestSeverity(t *testing.T) {
+	t.Parallel()
+
+	testdata := analysistest.TestData()
+	results := analysistest.Run(
+		t,
+		testdata,
+		newAnalyzer(t, loglint.Options{
+			Severity: map[string]string{
+				"lowercase": loglint.SeverityWarning,
+				"english":   loglint.SeverityOff,
+			},
+		}),
+		"severity",
+	)
+
+	want := map[string]string{
+		"lowercase":    loglint.SeverityWarning,
+		"specialchars": loglint.SeverityError,
+	}
+	for _, result := range results {
+		for _, diag := range result.Diagnostics {
+			rule, severity := loglint.SplitCategory(diag.Category)
+			if want[rule] != severity {
+				t.Errorf("%s: category %q, want severity %q", diag.Message, diag.Category, want[rule])
+			}
+		}
+	}
+}
+
+func TestOverrides(t *testing.T) {
+	t.Parallel()
+
+	testdata := analysistest.TestData()
+	results := analysistest.Run(
+		t,
+		testdata,
+		newAnalyzer(t, loglint.Options{
+			Overrides: []loglint.Override{
+				{
+					Root:              filepath.Join(testdata, "src"),
+					Paths:             []string{"overrides/**/legacy_*.go"},
+					DisabledRules:     []string{"lowercase"},
+					SensitivePatterns: []string{"session"},
+				},
+				{
+					Packages:      []string{"overrides/cmd/..."},
+					DisabledRules: []string{"specialchars"},
+					Severity:      map[string]string{"lowercase": loglint.SeverityWarning},
+				},
+			},
+		}),
+		"overrides", "overrides/cmd/tool",
+	)
+
+	for _, result := range results {
+		for _, diag := range result.Diagnostics {
+			_, severity := loglint.SplitCategory(diag.Category)
+			want := loglint.SeverityError
+			if result.Pass.Pkg.Path() == "overrides/cmd/tool" {
+				want = loglint.SeverityWarning
+			}
+			if severity != want {
+				t.Errorf("%s: severity %q, want %q", diag.Message, severity, want)
+			}
+		}
+	}
+}
+
+func TestExcludedFiles(t *testing.T) {
+	t.Parallel()
+
+	testdata := analysistest.TestData()
+	analysistest.Run(
+		t,
+		testdata,
+		newAnalyzer(t, loglint.Options{
+			Root:         filepath.Join(testdata, "src"),
+			ExcludePaths: []string{"generated/*_copy.go"},
+		}),
+		"generated",
+	)
+}
+
+func TestLintGenerated(t *testing.T) {
+	t.Parallel()
+
+	testdata := analysistest.TestData()
+	analysistest.Run(t, testdata, newAnalyzer(t, loglint.Options{LintGenerated: true}), "lintgenerated")
+}
+
+func TestNewAnalyzerRejectsInvalidSeverity(t *testing.T) {
+	t.Parallel()
+
+	for _, severity := range []map[string]string{
+		{"lowercase": "fatal"},
+		{"uppercase": loglint.SeverityError},
+	} {
+		if _, err := loglint.NewAnalyzer(loglint.Options{Severity: severity}); err == nil {
+			t.Fatalf("expected error for severity %v", severity)
+		}
+	}
+}
+
+func TestNewAnalyzerRejectsInvalidPattern(t *testing.T) {
+	t.Parallel()
+
+	_, err := loglint.NewAnalyzer(loglint.Options{
+		CustomPatterns: map[string]string{"broken": `[a-`},
+	})
+	if err == nil || !strings.Contains(err.Error(), `custom pattern "broken"`) {
+		t.Fatalf("expected invalid pattern error, got %v", err)
+	}
+}
+
+func TestSensitiveTypes(t *testing.T) {
+	t.Parallel()
+
+	testdata := analysistest.TestData()
+	analysistest.Run(
+		t,
+		testdata,
+		newAnalyzer(t, loglint.Options{SensitiveTypes: []string{"sensitivetypes.Token"}}),
+		"sensitivetypes",
+	)
+}
+
+func TestSecretDetectors(t *testing.T) {
+	t.Parallel()
+
+	testdata := analysistest.TestData()
+	analysistest.Run(
+		t,
+		testdata,
+		newAnalyzer(t, loglint.Options{
+			DisabledRules: []string{"specialchars"},
+			SecretDetectors: map[string]loglint.SecretDetector{
+				loglint.SecretBase64: {MinLength: 24},
+				loglint.SecretHex:    {Disabled: true},
+			},
+		}),
+		"secrets",
+	)
+}
+
+func TestNewAnalyzerRejectsUnknownDetectors(t *testing.T) {
+	t.Parallel()
+
+	_, err := loglint.NewAnalyzer(loglint.Options{
+		SecretDetectors: map[string]loglint.SecretDetector{"slack-token": {}},
+	})
+	if err == nil || !strings.Contains(err.Error(), `unknown secret detector "slack-token"`) {
+		t.Fatalf("expected unknown secret detector error, got %v", err)
+	}
+
+	_, err = loglint.NewAnalyzer(loglint.Options{PIIDetectors: []string{"passport"}})
+	if err == nil || !strings.Contains(err.Error(), `unknown pii detector "passport"`) {
+		t.Fatalf("expected unknown pii detector error, got %v", err)
+	}
+
+	_, err = loglint.NewAnalyzer(loglint.Options{RedactFunc: "redact"})
+	if err == nil || !strings.Contains(err.Error(), `redact func "redact"`) {
+		t.Fatalf("expected invalid redact func error, got %v", err)
+	}
+}
+
+func TestPIIDetectors(t *testing.T) {
+	t.Parallel()
+
+	testdata := analysistest.TestData()
+	analysistest.Run(
+		t,
+		testdata,
+		newAnalyzer(t, loglint.Options{
+			DisabledRules: []string{"specialchars"},
+			PIIDetectors: []string{
+				loglint.PIICreditCard, loglint.PIIIBAN, loglint.PIIEmail, loglint.PIIPhone, loglint.PIIIPv4,
+				loglint.PIIIPv6, loglint.PIIUSSSN, loglint.PIIRUINN, loglint.PIIRUSNILS,
+			},
+		}),
+		"pii",
+	)
+}
+
+func TestRedactFunc(t *testing.T) {
+	t.Parallel()
+
+	testdata := analysistest.TestData()
+	analysistest.RunWithSuggestedFixes(
+		t,
+		testdata,
+		newAnalyzer(t, loglint.Options{
+			DisabledRules: []string{"specialchars"},
+			RedactFunc:    "example.com/redact.String",
+		}),
+		"redaction",
+	)
+}
+
+func TestTaint(t *testing.T) {
+	t.Parallel()
+
+	analyzer := newAnalyzer(t, loglint.Options{
+		Taint: true,
+		TaintSources: []loglint.TaintSource{
+			{Kind: loglint.TaintField, Package: "taint", Type: "Config", Name: "SecretKey"},
+		},
+	})
+	analysistest.Run(t, analysistest.TestData(), analyzer, "taint")
+}
+
+func TestInventory(t *testing.T) {
+	t.Parallel()
+
+	inventory, err := loglint.NewInventory(loglint.Options{})
+	if err != nil {
+		t.Fatalf("NewInventory() error = %v", err)
+	}
+
+	results := analysistest.Run(t, analysistest.TestData(), inventory, "inventory")
+	calls, ok := results[0].Result.([]loglint.LogCall)
+	if !ok {
+		t.Fatalf("unexpected result type %T", results[0].Result)
+	}
+
+	for i := range calls {
+		calls[i].File = filepath.Base(calls[i].File)
+	}
+
+	want := []loglint.LogCall{
+		{File: "inventory.go", Line: 16, Column: 2, Func: "Service.Start", Library: "slog", Level: "info", Message: "server started", Keys: []string{"port", "mode"}},
+		{File: "inventory.go", Line: 17, Column: 2, Func: "Service.Start", Library: "zap", Level: "warn", Message: "port %d is busy"},
+		{File: "inventory.go", Line: 18, Column: 2, Func: "Service.Start", Library: "zerolog", Level: "error", Message: "connection lost", Keys: []string{"component", "attempt"}},
+		{File: "inventory.go", Line: 22, Column: 2, Func: "handle", Library: "logrus", Level: "info", Parts: []string{"login failed for "}},
+		{File: "inventory.go", Line: 23, Column: 2, Func: "handle", Library: "logrus", Level: "info", Message: "cache warmed"},
+	}
+	if !reflect.DeepEqual(calls, want) {
+		t.Fatalf("inventory =\n%+v\nwant\n%+v", calls, want)
+	}
+}
+
+func TestRulesDocumented(t *testing.T) {
+	t.Parallel()
+
+	for _, rule := range loglint.Rules() {
+		if rule.Short == "" || rule.Full == "" || rule.Help == "" {
+			t.Fatalf("rule %q has incomplete description: %+v", rule.Name, rule)
+		}
+
+		// Каждое правило из описаний принимается в Options.Severity.
+		if _, err := loglint.NewAnalyzer(loglint.Options{Severity: map[string]string{rule.Name: loglint.SeverityWarning}}); err != nil {
+			t.Fatalf("NewAnalyzer() for rule %q error = %v", rule.Name, err)
+		}
+	}
+}
+
+func newAnalyzer(t *testing.T, options loglint.Options) *analysis.Analyzer {
+	t.Helper()
+
+	analyzer, err := loglint.NewAnalyzer(options)
+	if err != nil {
+		t.Fatalf("NewAnalyzer() error = %v", err)
+	}
+
+	return analyzer
+}
@@ -1,6 +1,8 @@
 package loglint
 
 import (
+	"fmt"
+
 	"github.com/golangci/plugin-module-register/register"
 	"github.com/victornechaev/loglint/internal/config"
 	"golang.org/x/tools/go/analysis"
@@ -12,11 +14,62 @@ func init() {
 
 // Settings описывает конфигурацию loglint из YAML-настроек golangci-lint.
 type Settings struct {
-	SensitivePatterns []string          `json:"sensitive-patterns"`
-	CustomPatterns    map[string]string `json:"custom-patterns"`
+	SensitivePatterns []string                          `json:"sensitive-patterns"`
+	CustomPatterns    map[string]string                 `json:"custom-patterns"`
+	SensitiveTypes    []string                          `json:"sensitive-types"`
+	SecretDetectors   map[string]SecretDetectorSettings `json:"secret-detectors"`
+	PIIDetectors      []string                          `json:"pii-detectors"`
+	RedactFunc        string                            `json:"redact-func"`
+	DisabledRules     []string                          `json:"disabled-rules"`
+	AutoFix           *bool                             `json:"auto-fix"`
+	ConfigPath        string                            `json:"config-path"`
+	Loggers           []LoggerSettings                  `json:"loggers"`
+	KeyStyle          string                            `json:"key-style"`
+	BaselinePath      string                            `json:"baseline-path"`
+	Severity          map[string]string                 `json:"severity"`
+	Overrides         []OverrideSettings                `json:"overrides"`
+	ExcludePaths      []string                          `json:"exclude-paths"`
+	LintGenerated     *bool                             `json:"lint-generated"`
+	Taint             *bool                             `json:"taint"`
+	TaintSources      []TaintSourceSettings             `json:"taint-sources"`
+}
+
+// TaintSourceSettings описывает источник секретов для правила taint в YAML-настройках
+// golangci-lint. Kind — call, field или argument.
+type TaintSourceSettings struct {
+	Kind    string `json:"kind"`
+	Package string `json:"package"`
+	Type    string `json:"type"`
+	Name    string `json:"name"`
+	Arg     int    `json:"arg"`
+	Keyed   bool   `json:"keyed"`
+}
+
+// OverrideSettings описывает переопределение правил для части кода в YAML-настройках
+// golangci-lint. Пути задаются относительно каталога файла конфигурации loglint.
+type OverrideSettings struct {
+	Paths             []string          `json:"paths"`
+	Packages          []string          `json:"packages"`
 	DisabledRules     []string          `json:"disabled-rules"`
-	AutoFix           *bool             `json:"auto-fix"`
-	ConfigPath        string            `json:"config-path"`
+	SensitivePatterns []string          `json:"sensitive-patterns"`
+	Severity          map[string]string `json:"severity"`
+}
+
+// SecretDetectorSettings настраивает встроенный детектор секретов в YAML-настройках
+// golangci-lint.
+type SecretDetectorSettings struct {
+	Enabled    *bool   `json:"enabled"`
+	MinEntropy float64 `json:"min-entropy"`
+	MinLength  int     `json:"min-length"`
+}
+
+// LoggerSettings описывает пользовательский логгер в YAML-настройках golangci-lint.
+type LoggerSettings struct {
+	Package      string   `json:"package"`
+	Type         string   `json:"type"`
+	Methods      []string `json:"methods"`
+	MessageIndex int      `json:"message-index"`
+	Kind         string   `json:"kind"`
 }
 
 // Plugin — адаптер module-plugin, который ожидает golangci-lint.
@@ -35,13 +88,23 @@ func New(settings any) (register.LinterPlugin, error) {
 }
 
 func (p *Plugin) BuildAnalyzers() ([]*analysis.Analyzer, error) {
-	fileCfg, err := config.Load(p.settings.ConfigPath)
+	fileCfg, err := config.LoadTree(p.settings.ConfigPath)
 	if err != nil {
 		return nil, err
 	}
 
 	options := mergeConfigWithSettings(fileCfg, p.settings)
-	analyzer := NewAnalyzer(options)
+	if path := baselinePath(fileCfg, p.settings); path != "" {
+		if options.Baseline, err = LoadBaseline(path); err != nil {
+			return nil, fmt.Errorf("loglint: baseline: %w", err)
+		}
+	}
+
+	analyzer, err := NewAnalyzer(options)
+	if err != nil {
+		return nil, fmt.Errorf("loglint: %w", err)
+	}
+
 	return []*analysis.Analyzer{analyzer}, nil
 }
 
@@ -56,12 +119,137 @@ func mergeConfigWithSettings(cfg config.Config, settings Settings) Options {
 		autoFix = *settings.AutoFix
 	}
 
+	keyStyle := cfg.KeyStyle
+	if settings.KeyStyle != "" {
+		keyStyle = settings.KeyStyle
+	}
+
+	lintGenerated := cfg.LintGenerated
+	if settings.LintGenerated != nil {
+		lintGenerated = *settings.LintGenerated
+	}
+
+	redactFunc := cfg.RedactFunc
+	if settings.RedactFunc != "" {
+		redactFunc = settings.RedactFunc
+	}
+
+	taint := cfg.Taint.Enabled
+	if settings.Taint != nil {
+		taint = *settings.Taint
+	}
+
 	return Options{
 		SensitivePatterns: mergeStringSlices(cfg.SensitivePatterns, settings.SensitivePatterns),
 		CustomPatterns:    mergeStringMaps(cfg.CustomPatterns, settings.CustomPatterns),
+		SensitiveTypes:    mergeStringSlices(cfg.SensitiveTypes, settings.SensitiveTypes),
+		SecretDetectors:   mergeSecretDetectors(cfg.SecretDetectors, settings.SecretDetectors),
+		PIIDetectors:      mergeStringSlices(cfg.PIIDetectors, settings.PIIDetectors),
+		RedactFunc:        redactFunc,
 		DisabledRules:     mergeStringSlices(cfg.DisabledRules, settings.DisabledRules),
 		DisableFixes:      !autoFix,
+		Loggers:           mergeLoggers(cfg.Loggers, settings.Loggers),
+		KeyStyle:          keyStyle,
+		Severity:          mergeStringMaps(cfg.Severity, settings.Severity),
+		Overrides:         mergeOverrides(cfg, settings.Overrides),
+		Root:              cfg.Dir,
+		ExcludePaths:      mergeStringSlices(cfg.ExcludePaths, settings.ExcludePaths),
+		LintGenerated:     lintGenerated,
+		Taint:             taint,
+		TaintSources:      mergeTaintSources(cfg.Taint.Sources, settings.TaintSources),
+	}
+}
+
+func mergeSecretDetectors(base map[string]config.SecretDetector, override map[string]SecretDetectorSettings) map[string]SecretDetector {
+	// Настройки детектора из YAML заменяют настройки того же детектора из файла целиком.
+	merged := make(map[string]SecretDetector, len(base)+len(override))
+	for name, detector := range base {
+		merged[name] = secretDetector(detector.Enabled, detector.MinEntropy, detector.MinLength)
+	}
+	for name, detector := range override {
+		merged[name] = secretDetector(detector.Enabled, detector.MinEntropy, detector.MinLength)
 	}
+
+	return merged
+}
+
+func secretDetector(enabled *bool, minEntropy float64, minLength int) SecretDetector {
+	return SecretDetector{
+		Disabled:   enabled != nil && !*enabled,
+		MinEntropy: minEntropy,
+		MinLength:  minLength,
+	}
+}
+
+func mergeTaintSources(base []config.TaintSource, override []TaintSourceSettings) []TaintSource {
+	merged := make([]TaintSource, 0, len(base)+len(override))
+	for _, source := range base {
+		merged = append(merged, TaintSource(source))
+	}
+	for _, source := range override {
+		merged = append(merged, TaintSource(source))
+	}
+
+	return merged
+}
+
+func mergeOverrides(cfg config.Config, override []OverrideSettings) []Override {
+	// Переопределения из YAML идут последними и применяются поверх переопределений из файла.
+	merged := make([]Override, 0, len(cfg.Overrides)+len(override))
+	for _, o := range cfg.Overrides {
+		root := o.Dir
+		if root == "" {
+			root = cfg.Dir
+		}
+
+		merged = append(merged, Override{
+			Root:              root,
+			Paths:             o.Paths,
+			Packages:          o.Packages,
+			DisabledRules:     o.DisabledRules,
+			SensitivePatterns: o.SensitivePatterns,
+			Severity:          o.Severity,
+		})
+	}
+	for _, o := range override {
+		merged = append(merged, Override{
+			Root:              cfg.Dir,
+			Paths:             o.Paths,
+			Packages:          o.Packages,
+			DisabledRules:     o.DisabledRules,
+			SensitivePatterns: o.SensitivePatterns,
+			Severity:          o.Severity,
+		})
+	}
+
+	return merged
+}
+
+func baselinePath(cfg config.Config, settings Settings) string {
+	if settings.BaselinePath != "" {
+		return settings.BaselinePath
+	}
+
+	return cfg.Baseline
+}
+
+func mergeLoggers(base []config.Logger, override []LoggerSettings) []LoggerSpec {
+	// Записи из YAML идут последними, чтобы переопределять одноименные методы из файла.
+	merged := make([]LoggerSpec, 0, len(base)+len(override))
+	for _, logger := range base {
+		merged = append(merged, LoggerSpec{
+			Package:      logger.Package,
+			Type:         logger.Type,
+			Methods:      logger.Methods,
+			MessageIndex: logger.MessageIndex,
+			Kind:         logger.Kind,
+		})
+	}
+	for _, logger := range override {
+		merged = append(merged, LoggerSpec(logger))
+	}
+
+	return merged
 }
 
 func mergeStringMaps(base, override map[string]string) map[string]string {
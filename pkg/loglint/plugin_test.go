@@ -12,12 +12,21 @@ func TestMergeConfigWithSettings(t *testing.T) {
 	autoFix := false
 	options := mergeConfigWithSettings(
 		config.Config{
+			Dir:               "/repo",
 			SensitivePatterns: []string{"token"},
 			CustomPatterns: map[string]string{
 				"email": `.+@.+`,
 			},
 			AutoFix:       true,
 			DisabledRules: []string{"english"},
+			Loggers: []config.Logger{
+				{Package: "example.com/applog", Methods: []string{"Say"}, MessageIndex: 1},
+			},
+			KeyStyle: "camelCase",
+			Severity: map[string]string{"sensitive": "error", "lowercase": "warning"},
+			Overrides: []config.Override{
+				{Paths: []string{"cmd/**"}, DisabledRules: []string{"lowercase"}},
+			},
 		},
 		Settings{
 			SensitivePatterns: []string{"refresh token"},
@@ -26,6 +35,14 @@ func TestMergeConfigWithSettings(t *testing.T) {
 			},
 			DisabledRules: []string{"lowercase"},
 			AutoFix:       &autoFix,
+			Loggers: []LoggerSettings{
+				{Package: "example.com/applog", Type: "Logger", Methods: []string{"Note"}, Kind: KindKeyValue},
+			},
+			KeyStyle: KeyStyleSnake,
+			Severity: map[string]string{"lowercase": SeverityInfo},
+			Overrides: []OverrideSettings{
+				{Packages: []string{"example.com/app/internal/..."}, SensitivePatterns: []string{"session"}},
+			},
 		},
 	)
 
@@ -48,4 +65,20 @@ func TestMergeConfigWithSettings(t *testing.T) {
 	if len(options.DisabledRules) != 2 {
 		t.Fatalf("unexpected disabled rules: %#v", options.DisabledRules)
 	}
+
+	if options.KeyStyle != KeyStyleSnake {
+		t.Fatalf("unexpected key style: %q", options.KeyStyle)
+	}
+
+	if len(options.Loggers) != 2 || options.Loggers[0].MessageIndex != 1 || options.Loggers[1].Type != "Logger" {
+		t.Fatalf("unexpected loggers: %#v", options.Loggers)
+	}
+
+	if options.Severity["sensitive"] != SeverityError || options.Severity["lowercase"] != SeverityInfo {
+		t.Fatalf("unexpected severity: %#v", options.Severity)
+	}
+
+	if len(options.Overrides) != 2 || options.Overrides[0].Root != "/repo" || options.Overrides[1].Packages[0] != "example.com/app/internal/..." {
+		t.Fatalf("unexpected overrides: %#v", options.Overrides)
+	}
 }
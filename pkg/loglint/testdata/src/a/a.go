@@ -12,9 +12,10 @@ func bad(logger *slog.Logger, z *zap.Logger, sugar *zap.SugaredLogger, password,
 	logger.Error("ошибка подключения к базе данных")                  // want "contain only English language"
 	z.Warn("connection failed!!!")                                    // want "must not contain special symbols or emoji"
 	slog.WarnContext(context.Background(), "something went wrong...") // want "must not contain special symbols or emoji"
-	sugar.Infow("token: " + token)                                    // want "may contain sensitive data"
-	slog.Info("api_key=" + apiKey)                                    // want "may contain sensitive data"
+	sugar.Infow("token: " + token)                                    // want `may contain sensitive data \(default pattern: token, matched "token: "\)`
+	slog.Info("api_key=" + apiKey)                                    // want `may contain sensitive data \(default pattern: api key, matched "api_key="\)`
 	slog.Info("user password: " + password)                           // want "may contain sensitive data"
+	slog.Info("reset API_Key: done")                                  // want "must not contain special symbols or emoji" `may contain sensitive data \(default pattern: api key, matched "API_Key:"\)`
 	z.Info("server started 🚀")                                        // want "must not contain special symbols or emoji"
 	zap.L().Error("Failed to connect to database")                    // want "start with a lowercase letter"
 }
@@ -0,0 +1,42 @@
+package attrs
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/sirupsen/logrus"
+	"go.uber.org/zap"
+)
+
+type credentials struct {
+	Password string
+	User     string
+}
+
+func bad(ctx context.Context, logger *slog.Logger, z *zap.Logger, sugar *zap.SugaredLogger, pw, key, secret, name string, creds credentials) {
+	slog.Info("login", slog.String("password", pw))                                     // want "log attribute key may contain sensitive data"
+	slog.Info("login", slog.String("user", creds.Password))                             // want "log attribute value may contain sensitive data"
+	slog.Info("login", "user", name, "api_key", key)                                    // want "log attribute key may contain sensitive data"
+	logger.InfoContext(ctx, "login", "user", secret)                                    // want "log attribute value may contain sensitive data"
+	z.Info("login", zap.String("token", name))                                          // want "log attribute key may contain sensitive data"
+	z.With(zap.Any("user", secret)).Info("login")                                       // want "log attribute value may contain sensitive data"
+	sugar.Infow("login", "apiKey", key)                                                 // want "log attribute key may contain sensitive data"
+	sugar.With("secret", name).Info("login")                                            // want "log attribute key may contain sensitive data"
+	logger.With("user", name, "client_secret", name).Info("login")                      // want "log attribute key may contain sensitive data"
+	logger.WithGroup("credentials").Info("login")                                       // want "log attribute key may contain sensitive data"
+	slog.Info("login", slog.Group("auth", "token", name))                               // want "log attribute key may contain sensitive data"
+	logrus.WithField("password", pw).Info("login")                                      // want "log attribute key may contain sensitive data"
+	logrus.WithFields(logrus.Fields{"user": name, "token": key}).Info("login")          // want "log attribute key may contain sensitive data"
+	attrs := []slog.Attr{slog.String("user", name), slog.String("session_token", name)} // want "log attribute key may contain sensitive data"
+	_ = attrs
+}
+
+func good(logger *slog.Logger, z *zap.Logger, sugar *zap.SugaredLogger, name string, count int, err error, args []any) {
+	slog.Info("login", slog.String("user", name), slog.Int("attempts", count))
+	slog.Info("login", "user", name, slog.Int("attempts", count), "retries", count)
+	z.Info("login", zap.String("user", name), zap.Error(err), zap.Namespace("request"))
+	sugar.Infow("login", "user", name)
+	logger.With("user", name).WithGroup("request").Info("login")
+	logrus.WithField("user", name).Info("login")
+	slog.Info("login", args...)
+}
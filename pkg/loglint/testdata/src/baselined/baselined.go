@@ -0,0 +1,18 @@
+package baselined // want `stale baseline entry: lowercase finding "log message should start with a lowercase letter" in other.go: the file no longer exists`
+
+import "log/slog"
+
+type Server struct{}
+
+func (s *Server) Start() {
+	slog.Info("Starting server")
+	slog.Info("Listening") // want "should start with a lowercase letter"
+}
+
+func legacy(token string) {
+	slog.Info("token: " + token)
+}
+
+func cleaned() { // want `stale baseline entry: lowercase finding "log message should start with a lowercase letter" is no longer reported`
+	slog.Info("cleaned up")
+}
@@ -0,0 +1,19 @@
+package customlogger
+
+import (
+	"context"
+
+	"example.com/applog"
+)
+
+func bad(ctx context.Context, logger *applog.Logger, name string) {
+	logger.Note("Request handled", "user", name) // want "start with a lowercase letter"
+	logger.Notef("request %s failed!", name)     // want "must not contain special symbols or emoji"
+	applog.Say(ctx, "ошибка")                    // want "contain only English language"
+}
+
+func good(ctx context.Context, logger *applog.Logger, name string) {
+	logger.Note("request handled", "user", name)
+	logger.Notef("request %s failed", name)
+	applog.Say(ctx, "request handled")
+}
@@ -3,5 +3,11 @@ package custompattern
 import "log/slog"
 
 func customRegexPattern() {
-	slog.Info("order 1234 processed") // want "may contain sensitive data"
+	slog.Info("order 1234 processed")          // want `may contain sensitive data \(custom pattern: order-id, matched "…"\)`
+	slog.Info("mail sent to user@example.com") // want "special symbols" `may contain sensitive data \(custom pattern: email, matched "user…"\)`
+}
+
+func customKeyword(refreshToken, sessionID string) {
+	slog.Info("session rotated: " + sessionID)  // want `may contain sensitive data \(sensitive keyword: session, matched "session rotated: "\)`
+	slog.Info("rotated", "value", refreshToken) // want `log attribute value may contain sensitive data \(default pattern: token, matched "refreshToken"\)`
 }
@@ -0,0 +1,20 @@
+package directives
+
+import "log/slog"
+
+func suppressed(token string) {
+	slog.Info("Starting server") //loglint:ignore lowercase matches the upstream wire format
+
+	//loglint:ignore lowercase,specialchars legacy dashboard parses this message
+	slog.Info("Cache warmed!")
+
+	slog.Info("done")  //loglint:ignore english // want `unused loglint directive for rule "english"`
+	slog.Info("Ready") // want "should start with a lowercase letter"
+}
+
+func malformed() {
+	slog.Info("Missing reason") /* // want "should start with a lowercase letter" `malformed loglint directive: ignore requires a reason` */    //loglint:ignore lowercase
+	slog.Info("Unknown rule")   /* // want "should start with a lowercase letter" `malformed loglint directive: unknown rule "uppercase"` */    //loglint:ignore uppercase typo
+	slog.Info("Unknown kind")   /* // want "should start with a lowercase letter" `malformed loglint directive: unknown directive "ignroe"` */  //loglint:ignroe lowercase typo
+	slog.Info("No rules")       /* // want "should start with a lowercase letter" `malformed loglint directive: ignore requires a rule list` */ //loglint:ignore
+}
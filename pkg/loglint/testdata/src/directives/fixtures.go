@@ -0,0 +1,12 @@
+//loglint:file-ignore sensitive fixtures log fake credentials on purpose
+
+//loglint:file-ignore kvpairs nothing to suppress here // want `unused loglint directive for rule "kvpairs"`
+
+package directives
+
+import "log/slog"
+
+func fixtures(password string) {
+	slog.Info("fake password: " + password)
+	slog.Info("Fixture loaded") // want "should start with a lowercase letter"
+}
@@ -0,0 +1,11 @@
+package applog
+
+import "context"
+
+// Это stub внутреннего фасада логирования для проверки пользовательского реестра логгеров.
+type Logger struct{}
+
+func (l *Logger) Note(msg string, keysAndValues ...any) {}
+func (l *Logger) Notef(format string, args ...any)      {}
+
+func Say(ctx context.Context, msg string) {}
@@ -0,0 +1,6 @@
+package redact
+
+// Это stub функции маскирования для проверки автоисправления с RedactFunc.
+func String(value string) string {
+	return "***"
+}
@@ -1,6 +1,11 @@
 package fix
 
-import "log/slog"
+import (
+	"fmt"
+	"log/slog"
+
+	"github.com/sirupsen/logrus"
+)
 
 func bad(logger *slog.Logger, token string, password string) {
 	logger.Info("Starting server")            // want "start with a lowercase letter"
@@ -9,3 +14,21 @@ func bad(logger *slog.Logger, token string, password string) {
 	logger.Info("token: " + token)            // want "may contain sensitive data"
 	logger.Info("user password: " + password) // want "may contain sensitive data"
 }
+
+func badLogrus(name string, password string) {
+	logrus.Infof("Request %s handled", name)     // want "start with a lowercase letter"
+	logrus.Errorf("request failed: %-8v!", name) // want "must not contain special symbols or emoji"
+	logrus.Info("Server ", "started")            // want "start with a lowercase letter"
+	logrus.Infof("login with %s", password)      // want "may contain sensitive data"
+}
+
+func badSprintf(logger *slog.Logger, name string, token string) {
+	logger.Info(fmt.Sprintf("Request %s handled", name)) // want "start with a lowercase letter"
+	logger.Info(fmt.Sprintf("request %s failed!", name)) // want "must not contain special symbols or emoji"
+	logger.Info(fmt.Sprintf("login with %s", token))     // want "may contain sensitive data"
+}
+
+func badMixed(logger *slog.Logger, name string, password string, token string) {
+	logger.Info("user " + name + " logged in with password " + password) // want "may contain sensitive data"
+	logrus.Infof("user %s logged in with token %s", name, token)         // want "may contain sensitive data"
+}
@@ -0,0 +1,7 @@
+package generated
+
+import "log/slog"
+
+func handler() {
+	slog.Info("Handler called") // want "should start with a lowercase letter"
+}
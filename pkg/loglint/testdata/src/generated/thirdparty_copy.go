@@ -0,0 +1,7 @@
+package generated
+
+import "log/slog"
+
+func copied() {
+	slog.Info("Copied from upstream")
+}
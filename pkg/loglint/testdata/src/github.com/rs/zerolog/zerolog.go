@@ -0,0 +1,25 @@
+package zerolog
+
+// Это минимальный stub zerolog, который используется только в analysistest-фикстурах.
+type Logger struct{}
+
+type Event struct{}
+
+func New() Logger { return Logger{} }
+
+func (l Logger) Debug() *Event { return &Event{} }
+func (l Logger) Info() *Event  { return &Event{} }
+func (l Logger) Warn() *Event  { return &Event{} }
+func (l Logger) Error() *Event { return &Event{} }
+
+func (e *Event) Str(key, val string) *Event          { return e }
+func (e *Event) Int(key string, i int) *Event        { return e }
+func (e *Event) Bool(key string, b bool) *Event      { return e }
+func (e *Event) Interface(key string, i any) *Event  { return e }
+func (e *Event) Dict(key string, dict *Event) *Event { return e }
+func (e *Event) Err(err error) *Event                { return e }
+func (e *Event) Msg(msg string)                      {}
+func (e *Event) Msgf(format string, v ...any)        {}
+func (e *Event) Send()                               {}
+
+func Dict() *Event { return &Event{} }
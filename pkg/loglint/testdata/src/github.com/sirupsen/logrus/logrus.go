@@ -0,0 +1,40 @@
+package logrus
+
+// Это минимальный stub logrus, который используется только в analysistest-фикстурах.
+type Level uint32
+
+const InfoLevel Level = 4
+
+type Fields map[string]any
+
+type Logger struct{}
+
+type Entry struct{}
+
+func New() *Logger { return &Logger{} }
+
+func WithField(key string, value any) *Entry { return &Entry{} }
+func WithFields(fields Fields) *Entry        { return &Entry{} }
+
+func Info(args ...any)                  {}
+func Warn(args ...any)                  {}
+func Error(args ...any)                 {}
+func Infof(format string, args ...any)  {}
+func Errorf(format string, args ...any) {}
+func Debugf(format string, args ...any) {}
+func Infoln(args ...any)                {}
+func Warnln(args ...any)                {}
+
+func (l *Logger) WithField(key string, value any) *Entry       { return &Entry{} }
+func (l *Logger) WithFields(fields Fields) *Entry              { return &Entry{} }
+func (l *Logger) Info(args ...any)                             {}
+func (l *Logger) Warning(args ...any)                          {}
+func (l *Logger) Debugf(format string, args ...any)            {}
+func (l *Logger) Log(level Level, args ...any)                 {}
+func (l *Logger) Logf(level Level, format string, args ...any) {}
+
+func (e *Entry) WithField(key string, value any) *Entry { return e }
+func (e *Entry) Info(args ...any)                       {}
+func (e *Entry) Error(args ...any)                      {}
+func (e *Entry) Infof(format string, args ...any)       {}
+func (e *Entry) Errorln(args ...any)                    {}
@@ -33,3 +33,29 @@ func (s *SugaredLogger) Errorw(msg string, keysAndValues ...any)  {}
 func (s *SugaredLogger) DPanicw(msg string, keysAndValues ...any) {}
 func (s *SugaredLogger) Panicw(msg string, keysAndValues ...any)  {}
 func (s *SugaredLogger) Fatalw(msg string, keysAndValues ...any)  {}
+
+func (s *SugaredLogger) Debugf(template string, args ...any)  {}
+func (s *SugaredLogger) Infof(template string, args ...any)   {}
+func (s *SugaredLogger) Warnf(template string, args ...any)   {}
+func (s *SugaredLogger) Errorf(template string, args ...any)  {}
+func (s *SugaredLogger) DPanicf(template string, args ...any) {}
+func (s *SugaredLogger) Panicf(template string, args ...any)  {}
+func (s *SugaredLogger) Fatalf(template string, args ...any)  {}
+
+func (s *SugaredLogger) Debugln(args ...any)  {}
+func (s *SugaredLogger) Infoln(args ...any)   {}
+func (s *SugaredLogger) Warnln(args ...any)   {}
+func (s *SugaredLogger) Errorln(args ...any)  {}
+func (s *SugaredLogger) DPanicln(args ...any) {}
+func (s *SugaredLogger) Panicln(args ...any)  {}
+func (s *SugaredLogger) Fatalln(args ...any)  {}
+
+func String(key string, val string) Field { return Field{} }
+func Int(key string, val int) Field       { return Field{} }
+func Any(key string, value any) Field     { return Field{} }
+func Error(err error) Field               { return Field{} }
+func Namespace(key string) Field          { return Field{} }
+
+func (l *Logger) With(fields ...Field) *Logger           { return l }
+func (l *Logger) Sugar() *SugaredLogger                  { return &SugaredLogger{} }
+func (s *SugaredLogger) With(args ...any) *SugaredLogger { return s }
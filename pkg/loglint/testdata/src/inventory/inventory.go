@@ -0,0 +1,24 @@
+package inventory
+
+import (
+	"log/slog"
+
+	"github.com/rs/zerolog/log"
+	"github.com/sirupsen/logrus"
+	"go.uber.org/zap"
+)
+
+type Service struct {
+	logger *zap.SugaredLogger
+}
+
+func (s *Service) Start(port int) {
+	slog.Info("server started", "port", port, slog.String("mode", "http"))
+	s.logger.Warnf("port %d is busy", port)
+	log.Error().Str("component", "db").Int("attempt", 2).Msg("connection lost")
+}
+
+func handle(user string, l *logrus.Logger) {
+	logrus.Info("login failed for " + user)
+	l.Log(logrus.InfoLevel, "cache warmed")
+}
@@ -0,0 +1,23 @@
+package keystyle
+
+import (
+	"log/slog"
+
+	"go.uber.org/zap"
+)
+
+const requestKey = "requestID"
+
+func bad(logger *slog.Logger, z *zap.Logger, name string, id int) {
+	slog.Info("login", "userID", name)               // want `log attribute key "userID" should be snake_case`
+	slog.Info("login", slog.Int("retry-count", id))  // want `log attribute key "retry-count" should be snake_case`
+	z.Info("login", zap.String("http.method", name)) // want `log attribute key "http.method" should be snake_case`
+	logger.Info("login", "HTTPStatus", id)           // want `log attribute key "HTTPStatus" should be snake_case`
+	logger.Info("login", requestKey, id)             // want `log attribute key "requestID" should be snake_case`
+}
+
+func good(logger *slog.Logger, z *zap.Logger, name string, id int) {
+	slog.Info("login", "user_id", name)
+	slog.Info("login", slog.Int("retry_count", id), "ipv4_address", name)
+	z.Info("login", zap.String("http_method", name))
+}
@@ -0,0 +1,30 @@
+package kvpairs
+
+import (
+	"context"
+	"log/slog"
+
+	"go.uber.org/zap"
+)
+
+func bad(ctx context.Context, logger *slog.Logger, sugar *zap.SugaredLogger, name string, id int) {
+	slog.Info("login", "user", name, "attempts")                    // want "log attribute key has no value"
+	slog.Info("login", id, name)                                    // want "log attribute key should be a string, got int"
+	logger.InfoContext(ctx, "login", "user", name, "user", id)      // want `duplicate log attribute key "user"`
+	logger.With("user", name).Info("login", "user", id)             // want `duplicate log attribute key "user"`
+	logger.With("user", name).With("id", id).Warn("login", "id", 1) // want `duplicate log attribute key "id"`
+	logger.With("id", id, "id", 2)                                  // want `duplicate log attribute key "id"`
+	slog.Info("login", slog.String("user", name), "user", name)     // want `duplicate log attribute key "user"`
+	slog.Error("login", "level", "high")                            // want `log attribute key "level" collides with a reserved key`
+	sugar.Infow("login", "msg", name)                               // want `log attribute key "msg" collides with a reserved key`
+	sugar.With("user", name).Errorw("login", "user", name)          // want `duplicate log attribute key "user"`
+	sugar.Infow("login", "user")                                    // want "log attribute key has no value"
+}
+
+func good(logger *slog.Logger, sugar *zap.SugaredLogger, name string, id int, key any, args []any) {
+	slog.Info("login", "user", name, slog.Int("id", id))
+	logger.With("user", name).WithGroup("request").Info("login", "user", id)
+	logger.Info("login", key, name)
+	logger.Info("login", args...)
+	sugar.Infow("login", "user", name, "id", id)
+}
@@ -0,0 +1,28 @@
+package logruscalls
+
+import "github.com/sirupsen/logrus"
+
+func bad(logger *logrus.Logger, password, name string, count int) {
+	logrus.Info("Starting server")                                     // want "start with a lowercase letter"
+	logrus.Warn("ошибка подключения")                                  // want "contain only English language"
+	logrus.Error("connection failed!!!")                               // want "must not contain special symbols or emoji"
+	logrus.Info("user ", name, " password ", password)                 // want "may contain sensitive data"
+	logrus.Infof("Request %s handled", name)                           // want "start with a lowercase letter"
+	logrus.Errorf("request failed: %v", name)                          // want "must not contain special symbols or emoji"
+	logrus.Infoln("Server", "started")                                 // want "start with a lowercase letter"
+	logger.Warning("cache miss!")                                      // want "must not contain special symbols or emoji"
+	logger.Debugf("login with %s", password)                           // want "may contain sensitive data"
+	logger.Log(logrus.InfoLevel, "Shutting down")                      // want "start with a lowercase letter"
+	logger.Logf(logrus.InfoLevel, "Processed %d items", count)         // want "start with a lowercase letter"
+	logger.WithField("user", name).Info("Login succeeded")             // want "start with a lowercase letter"
+	logrus.WithFields(logrus.Fields{"id": 1}).Errorln("token: ", name) // want "may contain sensitive data"
+}
+
+func good(logger *logrus.Logger, name string, count int) {
+	logrus.Info("starting server")
+	logrus.Info("user ", name, " logged in")
+	logrus.Infof("processed %d items in %.2f seconds", count, 1.5)
+	logrus.Infoln("server", "started")
+	logger.WithField("user", name).Infof("user %s logged in", name)
+	logger.Info(count)
+}
@@ -0,0 +1,7 @@
+package main
+
+import "log/slog"
+
+func main() {
+	slog.Info("Tool started!") // want "should start with a lowercase letter"
+}
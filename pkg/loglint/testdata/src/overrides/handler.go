@@ -0,0 +1,8 @@
+package overrides
+
+import "log/slog"
+
+func handle(sessionID string) {
+	slog.Info("Request handled") // want "should start with a lowercase letter"
+	slog.Info("session opened: " + sessionID)
+}
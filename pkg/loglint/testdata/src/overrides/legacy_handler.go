@@ -0,0 +1,8 @@
+package overrides
+
+import "log/slog"
+
+func legacyHandle(sessionID string) {
+	slog.Info("Request handled")
+	slog.Info("session opened: " + sessionID) // want `sensitive keyword: session`
+}
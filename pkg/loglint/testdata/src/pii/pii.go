@@ -0,0 +1,45 @@
+package pii
+
+import (
+	"fmt"
+	"log/slog"
+)
+
+func cards(id string) {
+	slog.Info("charged 4111 1111 1111 1111")                     // want `log message may contain sensitive data \(pii detector: credit-card, matched "4111…"\)`
+	slog.Info(fmt.Sprintf("charged 4111-1111-1111-1111 %s", id)) // want `log message may contain sensitive data \(pii detector: credit-card, matched "4111…"\)`
+	slog.Info("order 4111 1111 1111 1112 shipped")
+}
+
+func banking() {
+	slog.Info("payout to DE89 3704 0044 0532 0130 00") // want `log message may contain sensitive data \(pii detector: iban, matched "DE89…"\)`
+	slog.Info("payout to GB82WEST12345698765432")      // want `log message may contain sensitive data \(pii detector: iban, matched "GB82…"\)`
+	slog.Info("payout to DE89 3704 0044 0532 0130 01")
+}
+
+func contacts(id string) {
+	slog.Info("invite sent to john.doe@example.com") // want `log message may contain sensitive data \(pii detector: email, matched "john…"\)`
+	slog.Info("calling +14155552671 for " + id)      // want `log message may contain sensitive data \(pii detector: phone, matched "\+141…"\)`
+	slog.Info("calling +74951234567 for " + id)      // want `log message may contain sensitive data \(pii detector: phone, matched "\+749…"\)`
+	slog.Info("calling +11155552671 for " + id)
+	slog.Info("calling +7495123456 for " + id)
+	slog.Info("calling +99912345678 for " + id)
+	slog.Info("build 2024 took 1500 ms")
+}
+
+func addresses() {
+	slog.Info("client 203.0.113.7 connected") // want `log message may contain sensitive data \(pii detector: ipv4, matched "203\.…"\)`
+	slog.Info("client 2001:db8::1 connected") // want `log message may contain sensitive data \(pii detector: ipv6, matched "2001…"\)`
+	slog.Info("listening on 127.0.0.1 and ::1")
+	slog.Info("started at 12:30:45")
+	slog.Info("version 1.20.3 released")
+}
+
+func nationalIDs() {
+	slog.Info("ssn 123-45-6789 verified") // want `log message may contain sensitive data \(pii detector: us-ssn, matched "123-…"\)`
+	slog.Info("ssn 666-45-6789 rejected")
+	slog.Info("inn 7707083893 verified") // want `log message may contain sensitive data \(pii detector: ru-inn, matched "7707…"\)`
+	slog.Info("inn 7707083890 rejected")
+	slog.Info("snils 112-233-445 95 verified") // want `log message may contain sensitive data \(pii detector: ru-snils, matched "112-…"\)`
+	slog.Info("snils 112-233-445 96 rejected")
+}
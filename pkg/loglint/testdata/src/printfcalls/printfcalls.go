@@ -0,0 +1,27 @@
+package printfcalls
+
+import (
+	"fmt"
+	"log/slog"
+
+	"go.uber.org/zap"
+)
+
+func bad(sugar *zap.SugaredLogger, token, password, name string, err error) {
+	sugar.Infof("Token %s", token)                        // want "start with a lowercase letter" "may contain sensitive data"
+	sugar.Errorf("failed to connect: %v", err)            // want "must not contain special symbols or emoji"
+	sugar.Warnf("ошибка %d", 42)                          // want "contain only English language"
+	sugar.Infoln("Server", "started")                     // want "start with a lowercase letter"
+	slog.Info(fmt.Sprintf("Token %s", token))             // want "start with a lowercase letter" "may contain sensitive data"
+	slog.Info(fmt.Sprintf("user %s logged in", password)) // want "may contain sensitive data"
+	slog.Error(fmt.Sprint("request ", "failed!"))         // want "must not contain special symbols or emoji"
+	slog.Warn(fmt.Sprintf("api_key=%s", name))            // want "must not contain special symbols or emoji" "may contain sensitive data"
+}
+
+func good(sugar *zap.SugaredLogger, logger *slog.Logger, name string, count int) {
+	sugar.Infof("processed %d items", count)
+	sugar.Debugf("request %-10s took %.2f seconds", name, 1.5)
+	sugar.Infof("user %[1]s logged in as %[1]q", name)
+	slog.Info(fmt.Sprintf("user %s logged in", name))
+	logger.Info(fmt.Sprint("server ", "started"))
+}
@@ -0,0 +1,15 @@
+package redaction
+
+import (
+	"log/slog"
+
+	mask "example.com/redact"
+	"github.com/sirupsen/logrus"
+)
+
+func aliasedImport(logger *slog.Logger, name string, password string, code int) {
+	logrus.Infof("user %s logged in with password %s", name, password) // want "may contain sensitive data"
+	logrus.Infof("user %s logged in with password %d", name, code)     // want "may contain sensitive data"
+	logger.Info("user password: " + mask.String(password))
+	logrus.Infof("user %s logged in with password %s", name, mask.String(password))
+}
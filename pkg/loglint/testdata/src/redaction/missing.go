@@ -0,0 +1,13 @@
+package redaction
+
+import (
+	"fmt"
+	"log/slog"
+)
+
+func missingImport(logger *slog.Logger, name string, password string, token string, code int) {
+	logger.Info("user password: " + password)                      // want "may contain sensitive data"
+	logger.Info("user " + name + " logged in with token " + token) // want "may contain sensitive data"
+	logger.Info(fmt.Sprintf("login with %s", token))               // want "may contain sensitive data"
+	logger.Info(fmt.Sprintf("100%% done with password %d", code))  // want "may contain sensitive data"
+}
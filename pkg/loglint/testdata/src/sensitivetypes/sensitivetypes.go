@@ -0,0 +1,67 @@
+package sensitivetypes
+
+import (
+	"fmt"
+	"log/slog"
+
+	"go.uber.org/zap"
+)
+
+type Credentials struct {
+	Login    string
+	Password string `log:"-"`
+}
+
+type User struct {
+	Name  string
+	Email string `json:"email" sensitive:"true"`
+	Auth  Credentials
+}
+
+type Account struct {
+	ID    int
+	Owner *User
+}
+
+type Device struct {
+	Name  string
+	Creds []Credentials
+}
+
+// Token входит в список SensitiveTypes.
+type Token struct {
+	Value string
+}
+
+type Session struct {
+	ID     string
+	Access Token
+}
+
+// Masked сам задает свой вывод, поэтому его поля не проверяются.
+type Masked struct {
+	Secret string `log:"-"`
+}
+
+func (Masked) String() string { return "***" }
+
+type Public struct {
+	ID   int
+	Name string
+}
+
+func structured(user User, account *Account, logger *zap.Logger) {
+	slog.Info("user loaded", slog.Any("user", user))         // want `log value of type User may contain sensitive data \(struct tag sensitive:"true", matched "User.Email"\)`
+	slog.Info("account loaded", "account", account)          // want `log value of type \*Account may contain sensitive data \(struct tag sensitive:"true", matched "Account.Owner.Email"\)`
+	logger.Info("login loaded", zap.Any("login", user.Auth)) // want `log value of type Credentials may contain sensitive data \(struct tag log:"-", matched "Credentials.Password"\)`
+	slog.Info("public loaded", slog.Any("public", Public{ID: 1}))
+	slog.Info("masked loaded", "masked", Masked{})
+}
+
+func formatted(devices []Device, session Session, sugar *zap.SugaredLogger) {
+	slog.Info(fmt.Sprintf("devices %v", devices)) // want `log value of type \[\]Device may contain sensitive data \(struct tag log:"-", matched "Device.Creds\[\].Password"\)`
+	sugar.Infof("session %d %+v", 1, session)     // want `log value of type Session may contain sensitive data \(sensitive type: sensitivetypes.Token, matched "Session.Access"\)`
+	sugar.Info("issued ", session.Access)         // want `log value of type Token may contain sensitive data \(sensitive type: sensitivetypes.Token, matched "Token"\)`
+	sugar.Infof("session %s", session.ID)
+	sugar.Infof("session %T", session)
+}
@@ -0,0 +1,9 @@
+package severity
+
+import "log/slog"
+
+func levels() {
+	slog.Info("Starting server")   // want "should start with a lowercase letter"
+	slog.Info("сервер запущен")    // english is off
+	slog.Info("server started!!!") // want "must not contain special symbols or emoji"
+}
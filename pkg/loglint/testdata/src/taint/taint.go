@@ -0,0 +1,73 @@
+package taint
+
+import (
+	"database/sql"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"os"
+
+	"github.com/rs/zerolog/log"
+
+	"taintlib"
+)
+
+func headers(r *http.Request) {
+	slog.Info("auth", "header", r.Header.Get("Authorization")) // want `log call may contain a secret from http.Header.Get`
+	slog.Info("request", "type", r.Header.Get("Content-Type"))
+	log.Info().Str("header", r.Header.Get("Authorization")).Msg("request") // want `log call may contain a secret from http.Header.Get`
+}
+
+func env() {
+	x := os.Getenv("DB_PASSWORD")
+	msg := fmt.Sprintf("connecting with %s", x)
+	slog.Info(msg) // want `log call may contain a secret from os.Getenv`
+
+	home := os.Getenv("HOME")
+	slog.Info("home", "dir", home)
+}
+
+func crossPackage(logger *slog.Logger) {
+	slog.Info("db", "value", taintlib.Describe("db", taintlib.DatabaseDSN())) // want `log call may contain a secret from os.Getenv`
+	taintlib.Record(logger, taintlib.DatabaseDSN())                           // want `log call may contain a secret from os.Getenv`
+	taintlib.Record(logger, "static")
+}
+
+func dataSource(user string) {
+	dsn := "postgres://" + user + "@db/app"
+	db, err := sql.Open("postgres", dsn)
+	if err != nil {
+		slog.Error("open failed", "target", dsn) // want `log call may contain a secret from argument 1 of sql.Open`
+		return
+	}
+	_ = db
+}
+
+type Config struct {
+	Name      string
+	SecretKey string
+}
+
+func configField(cfg *Config) {
+	k := cfg.SecretKey
+	slog.Info("config loaded", "name", cfg.Name)
+	slog.Info("config loaded", "k", k) // want `log call may contain a secret from taint.Config.SecretKey`
+}
+
+func suppressed() {
+	v := os.Getenv("API_TOKEN")
+	slog.Info("token loaded", "v", v) //loglint:ignore taint value is masked by the handler
+}
+
+func closure() {
+	v := os.Getenv("API_TOKEN")
+	logToken := func() {
+		slog.Info("token loaded", "v", v) // want `log call may contain a secret from os.Getenv`
+	}
+	logToken()
+
+	home := os.Getenv("HOME")
+	func() {
+		slog.Info("home", "dir", home)
+	}()
+}
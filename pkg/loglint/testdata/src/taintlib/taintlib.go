@@ -0,0 +1,21 @@
+package taintlib
+
+import (
+	"log/slog"
+	"os"
+)
+
+// DatabaseDSN возвращает секрет из окружения: сводка функции помечает ее результат.
+func DatabaseDSN() string {
+	return os.Getenv("DB_PASSWORD")
+}
+
+// Describe переносит значение аргумента в результат.
+func Describe(prefix, value string) string {
+	return prefix + ": " + value
+}
+
+// Record передает значение в логгер.
+func Record(logger *slog.Logger, value string) {
+	logger.Info("value recorded", "value", value)
+}
@@ -0,0 +1,27 @@
+package wrapperlib
+
+import (
+	"log/slog"
+
+	"github.com/sirupsen/logrus"
+)
+
+type Service struct {
+	log *slog.Logger
+}
+
+func (s *Service) LogInfo(msg string, args ...any) { // want LogInfo:"loggerWrapper\\(0, key-value\\)"
+	s.log.Info(msg, args...)
+}
+
+func Debugf(format string, args ...any) { // want Debugf:"loggerWrapper\\(0, printf\\)"
+	logrus.Debugf(format, args...)
+}
+
+func Print(args ...any) { // want Print:"loggerWrapper\\(0, plain\\)"
+	logrus.Info(args...)
+}
+
+func Prefixed(msg string) {
+	slog.Info("service: " + msg)
+}
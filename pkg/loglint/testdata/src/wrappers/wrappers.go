@@ -0,0 +1,43 @@
+package wrappers
+
+import (
+	"log/slog"
+
+	"wrapperlib"
+)
+
+type handler struct {
+	log *slog.Logger
+	svc *wrapperlib.Service
+}
+
+func (h *handler) serve(name, password string) {
+	h.logWarn("Request received", "user", name)             // want "start with a lowercase letter"
+	h.svc.LogInfo("request handled!")                       // want "must not contain special symbols or emoji"
+	wrapperlib.Debugf("Loading %s", name)                   // want "start with a lowercase letter"
+	wrapperlib.Print("user ", name, " password ", password) // want "may contain sensitive data"
+	alert("ошибка")                                         // want "contain only English language"
+	wrapperlib.Prefixed("Not a wrapper")
+}
+
+// alert объявлена раньше обертки, которую вызывает.
+func alert(text string) { // want alert:"loggerWrapper\\(0, key-value\\)"
+	defaultHandler.logWarn(text)
+}
+
+func (h *handler) logWarn(msg string, args ...any) { // want logWarn:"loggerWrapper\\(0, key-value\\)"
+	h.log.Warn(msg, args...)
+}
+
+var defaultHandler = &handler{}
+
+func notWrapper(msg string) {
+	go func() {
+		slog.Info(msg)
+	}()
+}
+
+func good(h *handler, name string) {
+	h.logWarn("request received", "user", name)
+	notWrapper("Not checked")
+}
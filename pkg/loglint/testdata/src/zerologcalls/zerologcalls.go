@@ -0,0 +1,24 @@
+package zerologcalls
+
+import (
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+)
+
+func bad(logger zerolog.Logger, password, name string, attempts int) {
+	log.Info().Msg("Starting server")                                               // want "start with a lowercase letter"
+	log.Warn().Str("user", name).Msg("ошибка подключения")                          // want "contain only English language"
+	logger.Error().Int("attempts", attempts).Msg("connection failed!!!")            // want "must not contain special symbols or emoji"
+	log.Info().Msgf("Request %s handled", name)                                     // want "start with a lowercase letter"
+	log.Debug().Msg("user password: " + password)                                   // want "may contain sensitive data"
+	log.Info().Str("password", password).Msg("user logged in")                      // want "log attribute key may contain sensitive data"
+	log.Info().Str("user", name).Interface("api_key", name).Send()                  // want "log attribute key may contain sensitive data"
+	(log.Error().Str("refresh_token", name)).Int("attempts", attempts).Msg("retry") // want "log attribute key may contain sensitive data"
+}
+
+func good(logger zerolog.Logger, name string, attempts int, err error) {
+	log.Info().Msg("starting server")
+	log.Info().Str("user", name).Int("attempts", attempts).Msgf("user %s logged in", name)
+	logger.Error().Err(err).Bool("retry", true).Msg("connection failed")
+	log.Info().Dict("request", zerolog.Dict().Str("id", name)).Send()
+}